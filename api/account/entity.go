@@ -5,6 +5,12 @@
 // Package account implements account entities and services
 package account // import "github.com/brunomvsouza/ynab.go/api/account"
 
+import (
+	"time"
+
+	"github.com/brunomvsouza/ynab.go/api"
+)
+
 // Account represents an account for a budget
 type Account struct {
 	ID       string `json:"id"`
@@ -22,6 +28,72 @@ type Account struct {
 	Deleted bool `json:"deleted"`
 
 	Note *string `json:"note"`
+	// TransferPayeeID The payee used when transferring to the account
+	TransferPayeeID *string `json:"transfer_payee_id"`
+	// DirectImportLinked Whether the account is linked to a financial
+	// institution for automatic transaction import
+	DirectImportLinked bool `json:"direct_import_linked"`
+	// DirectImportInError Whether the connection to the linked financial
+	// institution is in error
+	DirectImportInError bool `json:"direct_import_in_error"`
+	// LastReconciledAt When the account was last reconciled
+	LastReconciledAt *time.Time `json:"last_reconciled_at"`
+
+	// DebtOriginalBalance The original debt balance of a loan account in
+	// milliunits format
+	DebtOriginalBalance *int64 `json:"debt_original_balance"`
+	// DebtInterestRates The interest rates of a loan account by the date
+	// they are effective from, in milliunits format (e.g. 3375 is 3.375%)
+	DebtInterestRates DatedAmounts `json:"debt_interest_rates"`
+	// DebtMinimumPayments The minimum payments of a loan account by the
+	// date they are effective from, in milliunits format
+	DebtMinimumPayments DatedAmounts `json:"debt_minimum_payments"`
+	// DebtEscrowAmounts The escrow amounts of a loan account by the date
+	// they are effective from, in milliunits format
+	DebtEscrowAmounts DatedAmounts `json:"debt_escrow_amounts"`
+}
+
+// DatedAmounts represents amounts in milliunits format by the date they
+// are effective from. Its keys are decoded as dates at midnight UTC, so
+// prefer At over indexing it with dates built in other locations.
+type DatedAmounts map[api.Date]int64
+
+// At returns the amount effective on date, i.e. the one of the latest
+// date not after it, and whether there is any. Dates are compared by
+// their calendar day, regardless of their location.
+func (a DatedAmounts) At(date api.Date) (int64, bool) {
+	day := api.DateFormat(date)
+
+	var (
+		latest string
+		amount int64
+		found  bool
+	)
+	for d, v := range a {
+		from := api.DateFormat(d)
+		if from > day || (found && from <= latest) {
+			continue
+		}
+		latest, amount, found = from, v, true
+	}
+	return amount, found
+}
+
+// Latest returns the amount effective from the latest date along with the
+// date, and whether there is any
+func (a DatedAmounts) Latest() (api.Date, int64, bool) {
+	var (
+		latest api.Date
+		amount int64
+		found  bool
+	)
+	for d, v := range a {
+		if found && !d.After(latest.Time) {
+			continue
+		}
+		latest, amount, found = d, v, true
+	}
+	return latest, amount, found
 }
 
 // SearchResultSnapshot represents a versioned snapshot for an account search
@@ -28,6 +28,27 @@ const (
 	TypeMerchant Type = "merchantAccount"
 	// TypeInvestment DEPRECATED identifies an investment account
 	TypeInvestment Type = "investmentAccount"
-	// TypeMortgage DEPRECATED identifies a mortgage account
+	// TypeMortgage identifies a mortgage account
 	TypeMortgage Type = "mortgage"
+	// TypeAutoLoan identifies an auto loan account
+	TypeAutoLoan Type = "autoLoan"
+	// TypeStudentLoan identifies a student loan account
+	TypeStudentLoan Type = "studentLoan"
+	// TypePersonalLoan identifies a personal loan account
+	TypePersonalLoan Type = "personalLoan"
+	// TypeMedicalDebt identifies a medical debt account
+	TypeMedicalDebt Type = "medicalDebt"
+	// TypeOtherDebt identifies an other debt account
+	TypeOtherDebt Type = "otherDebt"
 )
+
+// IsLoan tells whether the account type is a loan or debt account,
+// which carries the debt fields of an Account
+func (t Type) IsLoan() bool {
+	switch t {
+	case TypeMortgage, TypeAutoLoan, TypeStudentLoan, TypePersonalLoan,
+		TypeMedicalDebt, TypeOtherDebt:
+		return true
+	}
+	return false
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/brunomvsouza/ynab.go"
 	"github.com/brunomvsouza/ynab.go/api"
+	"github.com/brunomvsouza/ynab.go/api/account"
 )
 
 func ExampleService_GetAccount() {
@@ -28,3 +29,16 @@ func ExampleService_GetAccounts() {
 
 	// Output: *account.SearchResultSnapshot
 }
+
+func ExampleService_CreateAccount() {
+	c := ynab.NewClient("<valid_ynab_access_token>")
+	p := account.PayloadAccount{
+		Name:    "Checking",
+		Type:    account.TypeChecking,
+		Balance: 100000,
+	}
+	account, _ := c.Account().CreateAccount("<valid_budget_id>", p)
+	fmt.Println(reflect.TypeOf(account))
+
+	// Output: *account.Account
+}
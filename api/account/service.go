@@ -5,24 +5,34 @@
 package account
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/brunomvsouza/ynab.go/api"
 )
 
 // NewService facilitates the creation of a new account service instance
-func NewService(c api.ClientReader) *Service {
+func NewService(c api.ClientReaderWriter) *Service {
 	return &Service{c}
 }
 
 // Service wraps YNAB account API endpoints
 type Service struct {
-	c api.ClientReader
+	c api.ClientReaderWriter
 }
 
 // GetAccounts fetches the list of accounts from a budget
 // https://api.youneedabudget.com/v1#/Accounts/getAccounts
 func (s *Service) GetAccounts(budgetID string, f *api.Filter) (*SearchResultSnapshot, error) {
+	return s.GetAccountsWithContext(context.Background(), budgetID, f)
+}
+
+// GetAccountsWithContext fetches the list of accounts from a budget bound to ctx
+// https://api.youneedabudget.com/v1#/Accounts/getAccounts
+func (s *Service) GetAccountsWithContext(ctx context.Context, budgetID string,
+	f *api.Filter) (*SearchResultSnapshot, error) {
+
 	resModel := struct {
 		Data struct {
 			Accounts        []*Account `json:"accounts"`
@@ -34,7 +44,7 @@ func (s *Service) GetAccounts(budgetID string, f *api.Filter) (*SearchResultSnap
 	if f != nil {
 		url = fmt.Sprintf("%s?%s", url, f.ToQuery())
 	}
-	if err := s.c.GET(url, &resModel); err != nil {
+	if err := s.c.GETWithContext(ctx, url, &resModel); err != nil {
 		return nil, err
 	}
 
@@ -47,6 +57,14 @@ func (s *Service) GetAccounts(budgetID string, f *api.Filter) (*SearchResultSnap
 // GetAccount fetches a specific account from a budget
 // https://api.youneedabudget.com/v1#/Accounts/getAccountById
 func (s *Service) GetAccount(budgetID, accountID string) (*Account, error) {
+	return s.GetAccountWithContext(context.Background(), budgetID, accountID)
+}
+
+// GetAccountWithContext fetches a specific account from a budget bound to ctx
+// https://api.youneedabudget.com/v1#/Accounts/getAccountById
+func (s *Service) GetAccountWithContext(ctx context.Context, budgetID,
+	accountID string) (*Account, error) {
+
 	resModel := struct {
 		Data struct {
 			Account *Account `json:"account"`
@@ -54,7 +72,42 @@ func (s *Service) GetAccount(budgetID, accountID string) (*Account, error) {
 	}{}
 
 	url := fmt.Sprintf("/budgets/%s/accounts/%s", budgetID, accountID)
-	if err := s.c.GET(url, &resModel); err != nil {
+	if err := s.c.GETWithContext(ctx, url, &resModel); err != nil {
+		return nil, err
+	}
+	return resModel.Data.Account, nil
+}
+
+// CreateAccount creates a new account for a budget
+// https://api.youneedabudget.com/v1#/Accounts/createAccount
+func (s *Service) CreateAccount(budgetID string, p PayloadAccount) (*Account, error) {
+	return s.CreateAccountWithContext(context.Background(), budgetID, p)
+}
+
+// CreateAccountWithContext creates a new account for a budget bound to ctx
+// https://api.youneedabudget.com/v1#/Accounts/createAccount
+func (s *Service) CreateAccountWithContext(ctx context.Context, budgetID string,
+	p PayloadAccount) (*Account, error) {
+
+	payload := struct {
+		Account *PayloadAccount `json:"account"`
+	}{
+		&p,
+	}
+
+	buf, err := json.Marshal(&payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resModel := struct {
+		Data struct {
+			Account *Account `json:"account"`
+		} `json:"data"`
+	}{}
+
+	url := fmt.Sprintf("/budgets/%s/accounts", budgetID)
+	if err := s.c.POSTWithContext(ctx, url, &resModel, buf); err != nil {
 		return nil, err
 	}
 	return resModel.Data.Account, nil
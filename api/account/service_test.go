@@ -1,8 +1,11 @@
 package account_test
 
 import (
+	"context"
+	"encoding/json"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/brunomvsouza/ynab.go/api"
 
@@ -121,3 +124,206 @@ func TestService_GetAccount(t *testing.T) {
 	}
 	assert.Equal(t, expected, a)
 }
+
+func TestService_GetAccount_loan(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/bbdccdb0-9007-42aa-a6fe-02a3e94476be/accounts/aa248caa-eed7-4575-a990-717386438d2c"
+	httpmock.RegisterResponder(http.MethodGet, url,
+		func(req *http.Request) (*http.Response, error) {
+			res := httpmock.NewStringResponse(200, `{
+  "data": {
+    "account": {
+      "id": "aa248caa-eed7-4575-a990-717386438d2c",
+      "name": "Car",
+      "type": "autoLoan",
+      "on_budget": false,
+      "closed": false,
+      "note": null,
+      "balance": -15000000,
+      "cleared_balance": -15000000,
+      "uncleared_balance": 0,
+      "transfer_payee_id": "34e88373-ef48-4386-9ab3-7f86c2a8988f",
+      "direct_import_linked": true,
+      "direct_import_in_error": false,
+      "last_reconciled_at": "2023-05-02T18:30:00Z",
+      "debt_original_balance": -20000000,
+      "debt_interest_rates": {
+        "2022-01-01": 3375,
+        "2023-01-01": 4125
+      },
+      "debt_minimum_payments": {
+        "2022-01-01": 350000
+      },
+      "debt_escrow_amounts": {},
+      "deleted": false
+    }
+  }
+}
+		`)
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	a, err := client.Account().GetAccount(
+		"bbdccdb0-9007-42aa-a6fe-02a3e94476be",
+		"aa248caa-eed7-4575-a990-717386438d2c",
+	)
+	assert.NoError(t, err)
+
+	var (
+		transferPayeeID           = "34e88373-ef48-4386-9ab3-7f86c2a8988f"
+		lastReconciledAt          = time.Date(2023, 5, 2, 18, 30, 0, 0, time.UTC)
+		debtOriginalBalance int64 = -20000000
+	)
+	jan2022, err := api.DateFromString("2022-01-01")
+	assert.NoError(t, err)
+	jan2023, err := api.DateFromString("2023-01-01")
+	assert.NoError(t, err)
+
+	expected := &account.Account{
+		ID:                  "aa248caa-eed7-4575-a990-717386438d2c",
+		Name:                "Car",
+		Type:                account.TypeAutoLoan,
+		Balance:             int64(-15000000),
+		ClearedBalance:      int64(-15000000),
+		TransferPayeeID:     &transferPayeeID,
+		DirectImportLinked:  true,
+		LastReconciledAt:    &lastReconciledAt,
+		DebtOriginalBalance: &debtOriginalBalance,
+		DebtInterestRates: account.DatedAmounts{
+			jan2022: 3375,
+			jan2023: 4125,
+		},
+		DebtMinimumPayments: account.DatedAmounts{
+			jan2022: 350000,
+		},
+		DebtEscrowAmounts: account.DatedAmounts{},
+	}
+	assert.Equal(t, expected, a)
+
+	// dates built in any location are looked up by their calendar day
+	loc := time.FixedZone("UTC-3", -3*60*60)
+	rate, ok := a.DebtInterestRates.At(api.Date{Time: time.Date(2023, 1, 1, 0, 0, 0, 0, loc)})
+	assert.True(t, ok)
+	assert.Equal(t, int64(4125), rate)
+
+	rate, ok = a.DebtInterestRates.At(api.Date{Time: time.Date(2022, 12, 31, 23, 0, 0, 0, loc)})
+	assert.True(t, ok)
+	assert.Equal(t, int64(3375), rate)
+
+	_, ok = a.DebtInterestRates.At(api.Date{Time: time.Date(2021, 12, 31, 0, 0, 0, 0, loc)})
+	assert.False(t, ok)
+
+	date, rate, ok := a.DebtInterestRates.Latest()
+	assert.True(t, ok)
+	assert.Equal(t, jan2023, date)
+	assert.Equal(t, int64(4125), rate)
+
+	_, _, ok = a.DebtEscrowAmounts.Latest()
+	assert.False(t, ok)
+	assert.True(t, a.Type.IsLoan())
+	assert.False(t, account.TypeChecking.IsLoan())
+}
+
+func TestService_GetAccountWithContext(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "value")
+
+	url := "https://api.youneedabudget.com/v1/budgets/bbdccdb0-9007-42aa-a6fe-02a3e94476be/accounts/aa248caa-eed7-4575-a990-717386438d2c"
+	httpmock.RegisterResponder(http.MethodGet, url,
+		func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "value", req.Context().Value(ctxKey{}))
+			res := httpmock.NewStringResponse(200, `{
+  "data": {
+    "account": {
+			"id": "aa248caa-eed7-4575-a990-717386438d2c",
+			"name": "Test Account",
+			"type": "checking"
+		}
+  }
+}
+		`)
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	a, err := client.Account().GetAccountWithContext(ctx,
+		"bbdccdb0-9007-42aa-a6fe-02a3e94476be",
+		"aa248caa-eed7-4575-a990-717386438d2c",
+	)
+	assert.NoError(t, err)
+
+	expected := &account.Account{
+		ID:   "aa248caa-eed7-4575-a990-717386438d2c",
+		Name: "Test Account",
+		Type: account.TypeChecking,
+	}
+	assert.Equal(t, expected, a)
+}
+
+func TestService_CreateAccount(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	payload := account.PayloadAccount{
+		Name:    "Savings",
+		Type:    account.TypeSavings,
+		Balance: int64(150000),
+	}
+
+	url := "https://api.youneedabudget.com/v1/budgets/bbdccdb0-9007-42aa-a6fe-02a3e94476be/accounts"
+	httpmock.RegisterResponder(http.MethodPost, url,
+		func(req *http.Request) (*http.Response, error) {
+			reqModel := struct {
+				Account *account.PayloadAccount `json:"account"`
+			}{}
+			err := json.NewDecoder(req.Body).Decode(&reqModel)
+			assert.NoError(t, err)
+			assert.Equal(t, &payload, reqModel.Account)
+
+			res := httpmock.NewStringResponse(201, `{
+  "data": {
+    "account": {
+      "id": "aa248caa-eed7-4575-a990-717386438d2c",
+      "name": "Savings",
+      "type": "savings",
+      "on_budget": true,
+      "closed": false,
+      "note": null,
+      "balance": 150000,
+      "cleared_balance": 150000,
+      "uncleared_balance": 0,
+      "deleted": false
+    }
+  }
+}
+		`)
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	a, err := client.Account().CreateAccount(
+		"bbdccdb0-9007-42aa-a6fe-02a3e94476be", payload)
+	assert.NoError(t, err)
+
+	expected := &account.Account{
+		ID:               "aa248caa-eed7-4575-a990-717386438d2c",
+		Name:             "Savings",
+		Type:             account.TypeSavings,
+		OnBudget:         true,
+		Closed:           false,
+		Balance:          int64(150000),
+		ClearedBalance:   int64(150000),
+		UnclearedBalance: int64(0),
+		Deleted:          false,
+	}
+	assert.Equal(t, expected, a)
+}
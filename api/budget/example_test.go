@@ -54,3 +54,11 @@ func ExampleService_GetBudgetSettings() {
 
 	// Output: *budget.Settings
 }
+
+func ExampleService_GetBudgetsWithAccounts() {
+	c := ynab.NewClient("<valid_ynab_access_token>")
+	budgets, _ := c.Budget().GetBudgetsWithAccounts()
+	fmt.Println(reflect.TypeOf(budgets))
+
+	// Output: []*budget.Summary
+}
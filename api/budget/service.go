@@ -5,6 +5,7 @@
 package budget
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/brunomvsouza/ynab.go/api"
@@ -23,13 +24,43 @@ type Service struct {
 // GetBudgets fetches the list of budgets of the logger in user
 // https://api.youneedabudget.com/v1#/Budgets/getBudgets
 func (s *Service) GetBudgets() ([]*Summary, error) {
+	return s.GetBudgetsWithContext(context.Background())
+}
+
+// GetBudgetsWithContext fetches the list of budgets of the logger in user
+// bound to ctx
+// https://api.youneedabudget.com/v1#/Budgets/getBudgets
+func (s *Service) GetBudgetsWithContext(ctx context.Context) ([]*Summary, error) {
 	resModel := struct {
 		Data struct {
 			Budgets []*Summary `json:"budgets"`
 		} `json:"data"`
 	}{}
 
-	if err := s.c.GET("/budgets", &resModel); err != nil {
+	if err := s.c.GETWithContext(ctx, "/budgets", &resModel); err != nil {
+		return nil, err
+	}
+	return resModel.Data.Budgets, nil
+}
+
+// GetBudgetsWithAccounts fetches the list of budgets of the logger in
+// user along with the accounts of each budget
+// https://api.youneedabudget.com/v1#/Budgets/getBudgets
+func (s *Service) GetBudgetsWithAccounts() ([]*Summary, error) {
+	return s.GetBudgetsWithAccountsWithContext(context.Background())
+}
+
+// GetBudgetsWithAccountsWithContext fetches the list of budgets of the
+// logger in user along with the accounts of each budget bound to ctx
+// https://api.youneedabudget.com/v1#/Budgets/getBudgets
+func (s *Service) GetBudgetsWithAccountsWithContext(ctx context.Context) ([]*Summary, error) {
+	resModel := struct {
+		Data struct {
+			Budgets []*Summary `json:"budgets"`
+		} `json:"data"`
+	}{}
+
+	if err := s.c.GETWithContext(ctx, "/budgets?include_accounts=true", &resModel); err != nil {
 		return nil, err
 	}
 	return resModel.Data.Budgets, nil
@@ -39,6 +70,15 @@ func (s *Service) GetBudgets() ([]*Summary, error) {
 // effectively a full budget export with filtering capabilities
 // https://api.youneedabudget.com/v1#/Budgets/getBudgetById
 func (s *Service) GetBudget(budgetID string, f *api.Filter) (*Snapshot, error) {
+	return s.GetBudgetWithContext(context.Background(), budgetID, f)
+}
+
+// GetBudgetWithContext fetches a single budget with all related entities,
+// effectively a full budget export with filtering capabilities bound to ctx
+// https://api.youneedabudget.com/v1#/Budgets/getBudgetById
+func (s *Service) GetBudgetWithContext(ctx context.Context, budgetID string,
+	f *api.Filter) (*Snapshot, error) {
+
 	resModel := struct {
 		Data struct {
 			Budget          *Budget `json:"budget"`
@@ -51,7 +91,7 @@ func (s *Service) GetBudget(budgetID string, f *api.Filter) (*Snapshot, error) {
 		url = fmt.Sprintf("%s?%s", url, f.ToQuery())
 	}
 
-	if err := s.c.GET(url, &resModel); err != nil {
+	if err := s.c.GETWithContext(ctx, url, &resModel); err != nil {
 		return nil, err
 	}
 
@@ -65,13 +105,31 @@ func (s *Service) GetBudget(budgetID string, f *api.Filter) (*Snapshot, error) {
 // entities, effectively a full budget export with filtering capabilities
 // https://api.youneedabudget.com/v1#/Budgets/getBudgetById
 func (s *Service) GetLastUsedBudget(f *api.Filter) (*Snapshot, error) {
+	return s.GetLastUsedBudgetWithContext(context.Background(), f)
+}
+
+// GetLastUsedBudgetWithContext fetches the last used budget with all related
+// entities, effectively a full budget export with filtering capabilities
+// bound to ctx
+// https://api.youneedabudget.com/v1#/Budgets/getBudgetById
+func (s *Service) GetLastUsedBudgetWithContext(ctx context.Context,
+	f *api.Filter) (*Snapshot, error) {
+
 	const lastUsedBudgetID = "last-used"
-	return s.GetBudget(lastUsedBudgetID, f)
+	return s.GetBudgetWithContext(ctx, lastUsedBudgetID, f)
 }
 
 // GetBudgetSettings fetches a budget settings
 // https://api.youneedabudget.com/v1#/Budgets/getBudgetSettingsById
 func (s *Service) GetBudgetSettings(budgetID string) (*Settings, error) {
+	return s.GetBudgetSettingsWithContext(context.Background(), budgetID)
+}
+
+// GetBudgetSettingsWithContext fetches a budget settings bound to ctx
+// https://api.youneedabudget.com/v1#/Budgets/getBudgetSettingsById
+func (s *Service) GetBudgetSettingsWithContext(ctx context.Context,
+	budgetID string) (*Settings, error) {
+
 	resModel := struct {
 		Data struct {
 			Settings *Settings `json:"settings"`
@@ -79,7 +137,7 @@ func (s *Service) GetBudgetSettings(budgetID string) (*Settings, error) {
 	}{}
 
 	url := fmt.Sprintf("/budgets/%s/settings", budgetID)
-	if err := s.c.GET(url, &resModel); err != nil {
+	if err := s.c.GETWithContext(ctx, url, &resModel); err != nil {
 		return nil, err
 	}
 
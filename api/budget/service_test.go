@@ -5,6 +5,7 @@
 package budget_test
 
 import (
+	"context"
 	"net/http"
 	"testing"
 	"time"
@@ -14,7 +15,9 @@ import (
 
 	"github.com/brunomvsouza/ynab.go"
 	"github.com/brunomvsouza/ynab.go/api"
+	"github.com/brunomvsouza/ynab.go/api/account"
 	"github.com/brunomvsouza/ynab.go/api/budget"
+	"github.com/brunomvsouza/ynab.go/api/transaction"
 )
 
 func TestService_GetBudgets(t *testing.T) {
@@ -173,6 +176,64 @@ func TestService_GetBudgets(t *testing.T) {
 	})
 }
 
+func TestService_GetBudgetsWithAccounts(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets?include_accounts=true"
+	httpmock.RegisterResponder(http.MethodGet, url,
+		func(req *http.Request) (*http.Response, error) {
+			res := httpmock.NewStringResponse(200, `{
+  "data": {
+    "budgets": [
+      {
+        "id": "aa248caa-eed7-4575-a990-717386438d2c",
+        "name": "TestBudget",
+        "accounts": [
+          {
+            "id": "09eaca5e-312a-4bcd-89c4-828fb90638f2",
+            "name": "Checking",
+            "type": "checking",
+            "on_budget": true,
+            "closed": false,
+            "balance": 120000,
+            "cleared_balance": 120000,
+            "uncleared_balance": 0,
+            "deleted": false
+          }
+        ]
+      }
+    ]
+  }
+}
+		`)
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	budgets, err := client.Budget().GetBudgetsWithAccounts()
+	assert.NoError(t, err)
+
+	expected := []*budget.Summary{
+		{
+			ID:   "aa248caa-eed7-4575-a990-717386438d2c",
+			Name: "TestBudget",
+			Accounts: []*account.Account{
+				{
+					ID:             "09eaca5e-312a-4bcd-89c4-828fb90638f2",
+					Name:           "Checking",
+					Type:           account.TypeChecking,
+					OnBudget:       true,
+					Balance:        int64(120000),
+					ClearedBalance: int64(120000),
+				},
+			},
+		},
+	}
+	assert.Equal(t, expected, budgets)
+}
+
 func TestService_GetBudget(t *testing.T) {
 	t.Run(`success`, func(t *testing.T) {
 		httpmock.Activate()
@@ -650,6 +711,62 @@ func TestService_GetBudget(t *testing.T) {
 	})
 }
 
+func TestService_GetBudget_delta(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c?last_knowledge_of_server=470"
+	httpmock.RegisterResponder(http.MethodGet, url,
+		func(req *http.Request) (*http.Response, error) {
+			res := httpmock.NewStringResponse(200, `{
+  "data": {
+    "budget": {
+      "id": "aa248caa-eed7-4575-a990-717386438d2c",
+      "name": "TestBudget",
+      "months": [
+        {
+          "month": "2018-03-01",
+          "deleted": true,
+          "categories": []
+        }
+      ],
+      "scheduled_subtransactions": [
+        {
+          "id": "9878f07c-6bd6-4b3e-a4a1-1b7d35f1f4d0",
+          "scheduled_transaction_id": "56f4fc86-2ed7-4b3b-9116-7a214261b3cd",
+          "amount": -1000,
+          "deleted": false
+        }
+      ]
+    },
+    "server_knowledge": 473
+  }
+}
+		`)
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	snapshot, err := client.Budget().GetBudget("aa248caa-eed7-4575-a990-717386438d2c",
+		&api.Filter{LastKnowledgeOfServer: 470})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(473), snapshot.ServerKnowledge)
+
+	if assert.Len(t, snapshot.Budget.Months, 1) {
+		assert.True(t, snapshot.Budget.Months[0].Deleted)
+	}
+
+	expectedScheduledSubTransactions := []*transaction.ScheduledSubTransaction{
+		{
+			ID:                     "9878f07c-6bd6-4b3e-a4a1-1b7d35f1f4d0",
+			ScheduledTransactionID: "56f4fc86-2ed7-4b3b-9116-7a214261b3cd",
+			Amount:                 int64(-1000),
+		},
+	}
+	assert.Equal(t, expectedScheduledSubTransactions, snapshot.Budget.ScheduledSubTransactions)
+}
+
 func TestService_GetLastUsedBudget(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
@@ -948,3 +1065,40 @@ func TestService_GetBudgetSettings(t *testing.T) {
 		assert.Equal(t, expected, settings)
 	})
 }
+
+func TestService_GetBudgetSettingsWithContext(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "value")
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/settings"
+	httpmock.RegisterResponder(http.MethodGet, url,
+		func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "value", req.Context().Value(ctxKey{}))
+			res := httpmock.NewStringResponse(200, `{
+  "data": {
+    "settings": {
+      "date_format": {
+        "format": "DD/MM/YYYY"
+      }
+    }
+  }
+}`)
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	settings, err := client.Budget().GetBudgetSettingsWithContext(ctx,
+		"aa248caa-eed7-4575-a990-717386438d2c")
+	assert.NoError(t, err)
+
+	expected := &budget.Settings{
+		DateFormat: &budget.DateFormat{
+			Format: "DD/MM/YYYY",
+		},
+	}
+	assert.Equal(t, expected, settings)
+}
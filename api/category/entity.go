@@ -5,7 +5,11 @@
 // Package category implements category entities and services
 package category // import "github.com/brunomvsouza/ynab.go/api/category"
 
-import "github.com/brunomvsouza/ynab.go/api"
+import (
+	"time"
+
+	"github.com/brunomvsouza/ynab.go/api"
+)
 
 // Category represents a category for a budget
 type Category struct {
@@ -37,6 +41,39 @@ type Category struct {
 	GoalTargetMonth *api.Date `json:"goal_target_month"`
 	// GoalPercentageComplete the percentage completion of the goal
 	GoalPercentageComplete *uint16 `json:"goal_percentage_complete"`
+	// GoalNeedsWholeAmount if the goal type is GoalNeed, whether the full
+	// target amount is needed at the start of the cadence (true) or the
+	// amount is refilled each period (false)
+	GoalNeedsWholeAmount *bool `json:"goal_needs_whole_amount"`
+	// GoalDay the day of the cadence the goal is due, a day of the week
+	// (0 is Sunday) for weekly goals and a day of the month otherwise
+	GoalDay *int `json:"goal_day"`
+	// GoalCadence how often the goal repeats
+	GoalCadence *GoalCadence `json:"goal_cadence"`
+	// GoalCadenceFrequency the interval of GoalCadence, e.g. 2 with
+	// GoalCadenceWeekly for a goal repeating every other week. Only applied
+	// to cadences 0, 1, 2 and 13, and ignored for the others.
+	GoalCadenceFrequency *int `json:"goal_cadence_frequency"`
+	// GoalMonthsToBudget the number of months, including the current one,
+	// left in the current goal period
+	GoalMonthsToBudget *int `json:"goal_months_to_budget"`
+	// GoalUnderFunded the amount of funding still needed in the current
+	// month to stay on track towards completing the goal within the current
+	// goal period in milliunits format
+	GoalUnderFunded *int64 `json:"goal_under_funded"`
+	// GoalOverallFunded the total amount funded towards the goal within the
+	// current goal period in milliunits format
+	GoalOverallFunded *int64 `json:"goal_overall_funded"`
+	// GoalOverallLeft the amount of funding still needed to complete the
+	// goal within the current goal period in milliunits format
+	GoalOverallLeft *int64 `json:"goal_overall_left"`
+	// GoalSnoozedAt when the goal was snoozed, nil when it is not snoozed
+	GoalSnoozedAt *time.Time `json:"goal_snoozed_at"`
+}
+
+// GoalSnoozed tells whether the goal of the category is snoozed
+func (c *Category) GoalSnoozed() bool {
+	return c.GoalSnoozedAt != nil
 }
 
 // Group represents a resumed category group for a budget
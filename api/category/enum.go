@@ -19,4 +19,28 @@ const (
 	GoalTargetCategoryBalanceByDate Goal = "TBD"
 	// GoalMonthlyFunding Goal by monthly funding
 	GoalMonthlyFunding Goal = "MF"
+	// GoalNeed Goal for a plan your spending target, funding a set amount
+	// every cadence
+	GoalNeed Goal = "NEED"
+	// GoalDebt Goal for paying down a debt account
+	GoalDebt Goal = "DEBT"
+)
+
+// GoalCadence represents how often a goal repeats. Values between 3 and
+// 12 repeat every 2 to 11 months respectively, i.e. every value - 1 months.
+// GoalCadenceFrequency on the category is only applied to the None,
+// Monthly, Weekly and Yearly cadences and is ignored for the others.
+type GoalCadence int
+
+const (
+	// GoalCadenceNone Goal does not repeat
+	GoalCadenceNone GoalCadence = 0
+	// GoalCadenceMonthly Goal repeats monthly
+	GoalCadenceMonthly GoalCadence = 1
+	// GoalCadenceWeekly Goal repeats weekly
+	GoalCadenceWeekly GoalCadence = 2
+	// GoalCadenceYearly Goal repeats yearly
+	GoalCadenceYearly GoalCadence = 13
+	// GoalCadenceEveryTwoYears Goal repeats every two years
+	GoalCadenceEveryTwoYears GoalCadence = 14
 )
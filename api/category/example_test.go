@@ -72,3 +72,51 @@ func ExampleService_UpdateCategoryForCurrentMonth() {
 
 	// Output: *category.Category
 }
+
+func ExampleService_UpdateCategory() {
+	name := "Gifts"
+	validPayload := category.PayloadCategory{Name: &name}
+
+	client := ynab.NewClient("<valid_ynab_access_token>")
+	c, _ := client.Category().UpdateCategory("<valid_budget_id>",
+		"<valid_category_id>", validPayload)
+	fmt.Println(reflect.TypeOf(c))
+
+	// Output: *category.Category
+}
+
+func ExampleService_CreateCategory() {
+	name := "Gifts"
+	groupID := "<valid_category_group_id>"
+	validPayload := category.PayloadCategory{
+		Name:            &name,
+		CategoryGroupID: &groupID,
+	}
+
+	client := ynab.NewClient("<valid_ynab_access_token>")
+	c, _ := client.Category().CreateCategory("<valid_budget_id>", validPayload)
+	fmt.Println(reflect.TypeOf(c))
+
+	// Output: *category.Category
+}
+
+func ExampleService_CreateCategoryGroup() {
+	validPayload := category.PayloadCategoryGroup{Name: "Fun"}
+
+	client := ynab.NewClient("<valid_ynab_access_token>")
+	g, _ := client.Category().CreateCategoryGroup("<valid_budget_id>", validPayload)
+	fmt.Println(reflect.TypeOf(g))
+
+	// Output: *category.Group
+}
+
+func ExampleService_UpdateCategoryGroup() {
+	validPayload := category.PayloadCategoryGroup{Name: "Just for Fun"}
+
+	client := ynab.NewClient("<valid_ynab_access_token>")
+	g, _ := client.Category().UpdateCategoryGroup("<valid_budget_id>",
+		"<valid_category_group_id>", validPayload)
+	fmt.Println(reflect.TypeOf(g))
+
+	// Output: *category.Group
+}
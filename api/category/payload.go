@@ -6,5 +6,30 @@ package category
 
 // PayloadMonthCategory is the payload contract for updating a category for a month
 type PayloadMonthCategory struct {
-	Budgeted int64
+	// Budgeted Budgeted amount in milliunits format. It is always sent, so
+	// it must be set even when only the note is being changed.
+	Budgeted int64 `json:"budgeted"`
+	// Note The category note for the month, left untouched when nil
+	Note *string `json:"note,omitempty"`
+}
+
+// PayloadCategory is the payload contract for saving a category, new or
+// existent. Name and CategoryGroupID are required on creation; on update,
+// fields left nil are not changed.
+type PayloadCategory struct {
+	Name *string `json:"name,omitempty"`
+	Note *string `json:"note,omitempty"`
+	// CategoryGroupID The category group the category is moved to
+	CategoryGroupID *string `json:"category_group_id,omitempty"`
+	// GoalTarget The goal target amount in milliunits format. It only
+	// applies to categories which already have a goal.
+	GoalTarget *int64 `json:"goal_target,omitempty"`
+}
+
+// PayloadCategoryGroup is the payload contract for saving a category
+// group, new or existent
+type PayloadCategoryGroup struct {
+	// Name The name of the category group. The name must be a maximum of
+	// 50 characters.
+	Name string `json:"name"`
 }
@@ -5,6 +5,7 @@
 package category
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -26,6 +27,15 @@ type Service struct {
 // GetCategories fetches the list of category groups for a budget
 // https://api.youneedabudget.com/v1#/Categories/getCategories
 func (s *Service) GetCategories(budgetID string, f *api.Filter) (*SearchResultSnapshot, error) {
+	return s.GetCategoriesWithContext(context.Background(), budgetID, f)
+}
+
+// GetCategoriesWithContext fetches the list of category groups for a budget
+// bound to ctx
+// https://api.youneedabudget.com/v1#/Categories/getCategories
+func (s *Service) GetCategoriesWithContext(ctx context.Context, budgetID string,
+	f *api.Filter) (*SearchResultSnapshot, error) {
+
 	resModel := struct {
 		Data struct {
 			CategoryGroups  []*GroupWithCategories `json:"category_groups"`
@@ -37,7 +47,7 @@ func (s *Service) GetCategories(budgetID string, f *api.Filter) (*SearchResultSn
 	if f != nil {
 		url = fmt.Sprintf("%s?%s", url, f.ToQuery())
 	}
-	if err := s.c.GET(url, &resModel); err != nil {
+	if err := s.c.GETWithContext(ctx, url, &resModel); err != nil {
 		return nil, err
 	}
 
@@ -50,6 +60,14 @@ func (s *Service) GetCategories(budgetID string, f *api.Filter) (*SearchResultSn
 // GetCategory fetches a specific category from a budget
 // https://api.youneedabudget.com/v1#/Categories/getCategoryById
 func (s *Service) GetCategory(budgetID, categoryID string) (*Category, error) {
+	return s.GetCategoryWithContext(context.Background(), budgetID, categoryID)
+}
+
+// GetCategoryWithContext fetches a specific category from a budget bound to ctx
+// https://api.youneedabudget.com/v1#/Categories/getCategoryById
+func (s *Service) GetCategoryWithContext(ctx context.Context, budgetID,
+	categoryID string) (*Category, error) {
+
 	resModel := struct {
 		Data struct {
 			Category *Category `json:"category"`
@@ -57,7 +75,7 @@ func (s *Service) GetCategory(budgetID, categoryID string) (*Category, error) {
 	}{}
 
 	url := fmt.Sprintf("/budgets/%s/categories/%s", budgetID, categoryID)
-	if err := s.c.GET(url, &resModel); err != nil {
+	if err := s.c.GETWithContext(ctx, url, &resModel); err != nil {
 		return nil, err
 	}
 	return resModel.Data.Category, nil
@@ -68,16 +86,37 @@ func (s *Service) GetCategory(budgetID, categoryID string) (*Category, error) {
 func (s *Service) GetCategoryForMonth(budgetID, categoryID string,
 	month api.Date) (*Category, error) {
 
-	return s.getCategoryForMonth(budgetID, categoryID, api.DateFormat(month))
+	return s.getCategoryForMonth(context.Background(), budgetID, categoryID,
+		api.DateFormat(month))
+}
+
+// GetCategoryForMonthWithContext fetches a specific category from a budget
+// month bound to ctx
+// https://api.youneedabudget.com/v1#/Categories/getMonthCategoryById
+func (s *Service) GetCategoryForMonthWithContext(ctx context.Context, budgetID,
+	categoryID string, month api.Date) (*Category, error) {
+
+	return s.getCategoryForMonth(ctx, budgetID, categoryID, api.DateFormat(month))
 }
 
 // GetCategoryForCurrentMonth fetches a specific category from the current budget month
 // https://api.youneedabudget.com/v1#/Categories/getMonthCategoryById
 func (s *Service) GetCategoryForCurrentMonth(budgetID, categoryID string) (*Category, error) {
-	return s.getCategoryForMonth(budgetID, categoryID, currentMonthID)
+	return s.getCategoryForMonth(context.Background(), budgetID, categoryID, currentMonthID)
+}
+
+// GetCategoryForCurrentMonthWithContext fetches a specific category from
+// the current budget month bound to ctx
+// https://api.youneedabudget.com/v1#/Categories/getMonthCategoryById
+func (s *Service) GetCategoryForCurrentMonthWithContext(ctx context.Context, budgetID,
+	categoryID string) (*Category, error) {
+
+	return s.getCategoryForMonth(ctx, budgetID, categoryID, currentMonthID)
 }
 
-func (s *Service) getCategoryForMonth(budgetID, categoryID, month string) (*Category, error) {
+func (s *Service) getCategoryForMonth(ctx context.Context, budgetID, categoryID,
+	month string) (*Category, error) {
+
 	resModel := struct {
 		Data struct {
 			Category *Category `json:"category"`
@@ -85,7 +124,7 @@ func (s *Service) getCategoryForMonth(budgetID, categoryID, month string) (*Cate
 	}{}
 
 	url := fmt.Sprintf("/budgets/%s/months/%s/categories/%s", budgetID, month, categoryID)
-	if err := s.c.GET(url, &resModel); err != nil {
+	if err := s.c.GETWithContext(ctx, url, &resModel); err != nil {
 		return nil, err
 	}
 	return resModel.Data.Category, nil
@@ -96,7 +135,16 @@ func (s *Service) getCategoryForMonth(budgetID, categoryID, month string) (*Cate
 func (s *Service) UpdateCategoryForMonth(budgetID, categoryID string, month api.Date,
 	p PayloadMonthCategory) (*Category, error) {
 
-	return s.updateCategoryForMonth(budgetID, categoryID, api.DateFormat(month), p)
+	return s.updateCategoryForMonth(context.Background(), budgetID, categoryID,
+		api.DateFormat(month), p)
+}
+
+// UpdateCategoryForMonthWithContext updates a category for a month bound to ctx
+// https://api.youneedabudget.com/v1#/Categories/updateMonthCategory
+func (s *Service) UpdateCategoryForMonthWithContext(ctx context.Context, budgetID,
+	categoryID string, month api.Date, p PayloadMonthCategory) (*Category, error) {
+
+	return s.updateCategoryForMonth(ctx, budgetID, categoryID, api.DateFormat(month), p)
 }
 
 // UpdateCategoryForCurrentMonth updates a category for the current month
@@ -104,11 +152,21 @@ func (s *Service) UpdateCategoryForMonth(budgetID, categoryID string, month api.
 func (s *Service) UpdateCategoryForCurrentMonth(budgetID, categoryID string,
 	p PayloadMonthCategory) (*Category, error) {
 
-	return s.updateCategoryForMonth(budgetID, categoryID, currentMonthID, p)
+	return s.updateCategoryForMonth(context.Background(), budgetID, categoryID,
+		currentMonthID, p)
 }
 
-func (s *Service) updateCategoryForMonth(budgetID, categoryID, month string,
-	p PayloadMonthCategory) (*Category, error) {
+// UpdateCategoryForCurrentMonthWithContext updates a category for the
+// current month bound to ctx
+// https://api.youneedabudget.com/v1#/Categories/updateMonthCategory
+func (s *Service) UpdateCategoryForCurrentMonthWithContext(ctx context.Context, budgetID,
+	categoryID string, p PayloadMonthCategory) (*Category, error) {
+
+	return s.updateCategoryForMonth(ctx, budgetID, categoryID, currentMonthID, p)
+}
+
+func (s *Service) updateCategoryForMonth(ctx context.Context, budgetID, categoryID,
+	month string, p PayloadMonthCategory) (*Category, error) {
 
 	payload := struct {
 		MonthCategory *PayloadMonthCategory `json:"month_category"`
@@ -130,8 +188,158 @@ func (s *Service) updateCategoryForMonth(budgetID, categoryID, month string,
 	url := fmt.Sprintf("/budgets/%s/months/%s/categories/%s", budgetID,
 		month, categoryID)
 
-	if err := s.c.PUT(url, &resModel, buf); err != nil {
+	if err := s.c.PUTWithContext(ctx, url, &resModel, buf); err != nil {
+		return nil, err
+	}
+	return resModel.Data.Category, nil
+}
+
+// UpdateCategory updates the name, note, goal target or category group of
+// a category
+// https://api.youneedabudget.com/v1#/Categories/updateCategory
+func (s *Service) UpdateCategory(budgetID, categoryID string,
+	p PayloadCategory) (*Category, error) {
+
+	return s.UpdateCategoryWithContext(context.Background(), budgetID, categoryID, p)
+}
+
+// UpdateCategoryWithContext updates the name, note, goal target or
+// category group of a category bound to ctx
+// https://api.youneedabudget.com/v1#/Categories/updateCategory
+func (s *Service) UpdateCategoryWithContext(ctx context.Context, budgetID,
+	categoryID string, p PayloadCategory) (*Category, error) {
+
+	payload := struct {
+		Category *PayloadCategory `json:"category"`
+	}{
+		&p,
+	}
+
+	buf, err := json.Marshal(&payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resModel := struct {
+		Data struct {
+			Category *Category `json:"category"`
+		} `json:"data"`
+	}{}
+
+	url := fmt.Sprintf("/budgets/%s/categories/%s", budgetID, categoryID)
+	if err := s.c.PATCHWithContext(ctx, url, &resModel, buf); err != nil {
+		return nil, err
+	}
+	return resModel.Data.Category, nil
+}
+
+// CreateCategory creates a new category in a category group of a budget
+// https://api.youneedabudget.com/v1#/Categories/createCategory
+func (s *Service) CreateCategory(budgetID string, p PayloadCategory) (*Category, error) {
+	return s.CreateCategoryWithContext(context.Background(), budgetID, p)
+}
+
+// CreateCategoryWithContext creates a new category in a category group of
+// a budget bound to ctx
+// https://api.youneedabudget.com/v1#/Categories/createCategory
+func (s *Service) CreateCategoryWithContext(ctx context.Context, budgetID string,
+	p PayloadCategory) (*Category, error) {
+
+	payload := struct {
+		Category *PayloadCategory `json:"category"`
+	}{
+		&p,
+	}
+
+	buf, err := json.Marshal(&payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resModel := struct {
+		Data struct {
+			Category *Category `json:"category"`
+		} `json:"data"`
+	}{}
+
+	url := fmt.Sprintf("/budgets/%s/categories", budgetID)
+	if err := s.c.POSTWithContext(ctx, url, &resModel, buf); err != nil {
 		return nil, err
 	}
 	return resModel.Data.Category, nil
 }
+
+// CreateCategoryGroup creates a new category group for a budget
+// https://api.youneedabudget.com/v1#/Category_Groups/createCategoryGroup
+func (s *Service) CreateCategoryGroup(budgetID string, p PayloadCategoryGroup) (*Group, error) {
+	return s.CreateCategoryGroupWithContext(context.Background(), budgetID, p)
+}
+
+// CreateCategoryGroupWithContext creates a new category group for a budget
+// bound to ctx
+// https://api.youneedabudget.com/v1#/Category_Groups/createCategoryGroup
+func (s *Service) CreateCategoryGroupWithContext(ctx context.Context, budgetID string,
+	p PayloadCategoryGroup) (*Group, error) {
+
+	payload := struct {
+		CategoryGroup *PayloadCategoryGroup `json:"category_group"`
+	}{
+		&p,
+	}
+
+	buf, err := json.Marshal(&payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resModel := struct {
+		Data struct {
+			CategoryGroup *Group `json:"category_group"`
+		} `json:"data"`
+	}{}
+
+	url := fmt.Sprintf("/budgets/%s/category_groups", budgetID)
+	if err := s.c.POSTWithContext(ctx, url, &resModel, buf); err != nil {
+		return nil, err
+	}
+	return resModel.Data.CategoryGroup, nil
+}
+
+// UpdateCategoryGroup updates a category group of a budget, e.g. to rename it
+// https://api.youneedabudget.com/v1#/Category_Groups/updateCategoryGroup
+func (s *Service) UpdateCategoryGroup(budgetID, categoryGroupID string,
+	p PayloadCategoryGroup) (*Group, error) {
+
+	return s.UpdateCategoryGroupWithContext(context.Background(), budgetID,
+		categoryGroupID, p)
+}
+
+// UpdateCategoryGroupWithContext updates a category group of a budget
+// bound to ctx
+// https://api.youneedabudget.com/v1#/Category_Groups/updateCategoryGroup
+func (s *Service) UpdateCategoryGroupWithContext(ctx context.Context, budgetID,
+	categoryGroupID string, p PayloadCategoryGroup) (*Group, error) {
+
+	payload := struct {
+		CategoryGroup *PayloadCategoryGroup `json:"category_group"`
+	}{
+		&p,
+	}
+
+	buf, err := json.Marshal(&payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resModel := struct {
+		Data struct {
+			CategoryGroup *Group `json:"category_group"`
+		} `json:"data"`
+	}{}
+
+	url := fmt.Sprintf("/budgets/%s/category_groups/%s", budgetID, categoryGroupID)
+	if err := s.c.PATCHWithContext(ctx, url, &resModel, buf); err != nil {
+		return nil, err
+	}
+	return resModel.Data.CategoryGroup, nil
+}
@@ -5,9 +5,12 @@
 package category_test
 
 import (
+	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/jarcoal/httpmock.v1"
@@ -174,6 +177,93 @@ func TestService_GetCategory(t *testing.T) {
 	assert.Equal(t, expected, c)
 }
 
+func TestService_GetCategory_goal(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/categories/13419c12-78d3-4a26-82ca-1cde7aa1d6f8"
+	httpmock.RegisterResponder(http.MethodGet, url,
+		func(req *http.Request) (*http.Response, error) {
+			res := httpmock.NewStringResponse(200, `{
+  "data": {
+    "category": {
+			"id": "13419c12-78d3-4a26-82ca-1cde7aa1d6f8",
+			"category_group_id": "13419c12-78d3-4818-a5dc-601b2b8a6064",
+			"name": "Rent",
+			"hidden": false,
+			"budgeted": 50000,
+			"activity": 0,
+			"balance": 50000,
+			"deleted": false,
+			"goal_type": "NEED",
+			"goal_needs_whole_amount": true,
+			"goal_day": 5,
+			"goal_cadence": 1,
+			"goal_cadence_frequency": 1,
+			"goal_creation_month": "2018-04-01",
+			"goal_target": 120000,
+			"goal_target_month": null,
+			"goal_percentage_complete": 41,
+			"goal_months_to_budget": 1,
+			"goal_under_funded": 70000,
+			"goal_overall_funded": 50000,
+			"goal_overall_left": 70000,
+			"goal_snoozed_at": "2018-04-10T12:30:00Z"
+    }
+	}
+}
+		`)
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	c, err := client.Category().GetCategory(
+		"aa248caa-eed7-4575-a990-717386438d2c",
+		"13419c12-78d3-4a26-82ca-1cde7aa1d6f8",
+	)
+	assert.NoError(t, err)
+
+	var (
+		expectedGoalTarget             int64  = 120000
+		expectedGoalPercentageComplete uint16 = 41
+		expectedGoalNeedsWholeAmount          = true
+		expectedGoalDay                       = 5
+		expectedGoalCadence                   = category.GoalCadenceMonthly
+		expectedGoalCadenceFrequency          = 1
+		expectedGoalMonthsToBudget            = 1
+		expectedGoalUnderFunded        int64  = 70000
+		expectedGoalOverallFunded      int64  = 50000
+		expectedGoalOverallLeft        int64  = 70000
+		expectedGoalSnoozedAt                 = time.Date(2018, 4, 10, 12, 30, 0, 0, time.UTC)
+	)
+	expectedGoalCreationMonth, err := api.DateFromString("2018-04-01")
+	assert.NoError(t, err)
+
+	expected := &category.Category{
+		ID:                     "13419c12-78d3-4a26-82ca-1cde7aa1d6f8",
+		CategoryGroupID:        "13419c12-78d3-4818-a5dc-601b2b8a6064",
+		Name:                   "Rent",
+		Budgeted:               int64(50000),
+		Balance:                int64(50000),
+		GoalType:               category.GoalNeed.Pointer(),
+		GoalCreationMonth:      &expectedGoalCreationMonth,
+		GoalTarget:             &expectedGoalTarget,
+		GoalPercentageComplete: &expectedGoalPercentageComplete,
+		GoalNeedsWholeAmount:   &expectedGoalNeedsWholeAmount,
+		GoalDay:                &expectedGoalDay,
+		GoalCadence:            &expectedGoalCadence,
+		GoalCadenceFrequency:   &expectedGoalCadenceFrequency,
+		GoalMonthsToBudget:     &expectedGoalMonthsToBudget,
+		GoalUnderFunded:        &expectedGoalUnderFunded,
+		GoalOverallFunded:      &expectedGoalOverallFunded,
+		GoalOverallLeft:        &expectedGoalOverallLeft,
+		GoalSnoozedAt:          &expectedGoalSnoozedAt,
+	}
+	assert.Equal(t, expected, c)
+	assert.True(t, c.GoalSnoozed())
+}
+
 func TestService_GetCategoryForMonth(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
@@ -470,3 +560,311 @@ func TestService_UpdateCategoryForCurrentMonth(t *testing.T) {
 	}
 	assert.Equal(t, expected, c)
 }
+
+func TestService_UpdateCategoryForMonthWithContext(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "value")
+
+	payload := category.PayloadMonthCategory{
+		Budgeted: 1000,
+	}
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/months/2018-01-01/categories/13419c12-78d3-4a26-82ca-1cde7aa1d6f8"
+	httpmock.RegisterResponder(http.MethodPut, url,
+		func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "value", req.Context().Value(ctxKey{}))
+			res := httpmock.NewStringResponse(200, `{
+  "data": {
+    "category": {
+			"id": "13419c12-78d3-4a26-82ca-1cde7aa1d6f8",
+			"budgeted": 1000
+    }
+	}
+}
+		`)
+			return res, nil
+		},
+	)
+
+	date, err := api.DateFromString("2018-01-01")
+	assert.NoError(t, err)
+
+	client := ynab.NewClient("")
+	c, err := client.Category().UpdateCategoryForMonthWithContext(ctx,
+		"aa248caa-eed7-4575-a990-717386438d2c",
+		"13419c12-78d3-4a26-82ca-1cde7aa1d6f8",
+		date,
+		payload,
+	)
+	assert.NoError(t, err)
+
+	expected := &category.Category{
+		ID:       "13419c12-78d3-4a26-82ca-1cde7aa1d6f8",
+		Budgeted: int64(1000),
+	}
+	assert.Equal(t, expected, c)
+}
+
+func TestService_UpdateCategoryForMonth_note(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	note := "birthday gifts"
+	payload := category.PayloadMonthCategory{
+		Budgeted: 1000,
+		Note:     &note,
+	}
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/months/current/categories/13419c12-78d3-4a26-82ca-1cde7aa1d6f8"
+	httpmock.RegisterResponder(http.MethodPut, url,
+		func(req *http.Request) (*http.Response, error) {
+			body, err := io.ReadAll(req.Body)
+			assert.NoError(t, err)
+			assert.JSONEq(t, `{"month_category":{"budgeted":1000,"note":"birthday gifts"}}`, string(body))
+
+			res := httpmock.NewStringResponse(200, `{
+  "data": {
+    "category": {
+			"id": "13419c12-78d3-4a26-82ca-1cde7aa1d6f8",
+			"budgeted": 1000,
+			"note": "birthday gifts"
+    }
+	}
+}
+		`)
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	c, err := client.Category().UpdateCategoryForCurrentMonth(
+		"aa248caa-eed7-4575-a990-717386438d2c",
+		"13419c12-78d3-4a26-82ca-1cde7aa1d6f8",
+		payload,
+	)
+	assert.NoError(t, err)
+
+	expected := &category.Category{
+		ID:       "13419c12-78d3-4a26-82ca-1cde7aa1d6f8",
+		Budgeted: int64(1000),
+		Note:     &note,
+	}
+	assert.Equal(t, expected, c)
+}
+
+func TestService_UpdateCategory(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	name := "Gifts"
+	groupID := "13419c12-78d3-4818-a5dc-601b2b8a6064"
+	var goalTarget int64 = 50000
+
+	payload := category.PayloadCategory{
+		Name:            &name,
+		CategoryGroupID: &groupID,
+		GoalTarget:      &goalTarget,
+	}
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/categories/13419c12-78d3-4a26-82ca-1cde7aa1d6f8"
+	httpmock.RegisterResponder(http.MethodPatch, url,
+		func(req *http.Request) (*http.Response, error) {
+			body, err := io.ReadAll(req.Body)
+			assert.NoError(t, err)
+			assert.JSONEq(t, `{
+  "category": {
+    "name": "Gifts",
+    "category_group_id": "13419c12-78d3-4818-a5dc-601b2b8a6064",
+    "goal_target": 50000
+  }
+}`, string(body))
+
+			res := httpmock.NewStringResponse(200, `{
+  "data": {
+    "category": {
+			"id": "13419c12-78d3-4a26-82ca-1cde7aa1d6f8",
+			"category_group_id": "13419c12-78d3-4818-a5dc-601b2b8a6064",
+			"name": "Gifts",
+			"hidden": false,
+			"original_category_group_id": null,
+			"note": null,
+			"budgeted": 1000,
+			"activity": 0,
+			"balance": 1000,
+			"deleted": false,
+			"goal_type": "TB",
+			"goal_target": 50000
+    },
+    "server_knowledge": 100
+	}
+}
+		`)
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	c, err := client.Category().UpdateCategory(
+		"aa248caa-eed7-4575-a990-717386438d2c",
+		"13419c12-78d3-4a26-82ca-1cde7aa1d6f8",
+		payload,
+	)
+	assert.NoError(t, err)
+
+	expected := &category.Category{
+		ID:              "13419c12-78d3-4a26-82ca-1cde7aa1d6f8",
+		CategoryGroupID: groupID,
+		Name:            name,
+		Budgeted:        int64(1000),
+		Balance:         int64(1000),
+		GoalType:        category.GoalTargetCategoryBalance.Pointer(),
+		GoalTarget:      &goalTarget,
+	}
+	assert.Equal(t, expected, c)
+}
+
+func TestService_CreateCategory(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	name := "Gifts"
+	groupID := "13419c12-78d3-4818-a5dc-601b2b8a6064"
+
+	payload := category.PayloadCategory{
+		Name:            &name,
+		CategoryGroupID: &groupID,
+	}
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/categories"
+	httpmock.RegisterResponder(http.MethodPost, url,
+		func(req *http.Request) (*http.Response, error) {
+			body, err := io.ReadAll(req.Body)
+			assert.NoError(t, err)
+			assert.JSONEq(t, `{
+  "category": {
+    "name": "Gifts",
+    "category_group_id": "13419c12-78d3-4818-a5dc-601b2b8a6064"
+  }
+}`, string(body))
+
+			res := httpmock.NewStringResponse(201, `{
+  "data": {
+    "category": {
+			"id": "13419c12-78d3-4a26-82ca-1cde7aa1d6f8",
+			"category_group_id": "13419c12-78d3-4818-a5dc-601b2b8a6064",
+			"name": "Gifts",
+			"hidden": false,
+			"note": null,
+			"budgeted": 0,
+			"activity": 0,
+			"balance": 0,
+			"deleted": false,
+			"goal_type": null
+    },
+    "server_knowledge": 101
+	}
+}
+		`)
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	c, err := client.Category().CreateCategory("aa248caa-eed7-4575-a990-717386438d2c", payload)
+	assert.NoError(t, err)
+
+	expected := &category.Category{
+		ID:              "13419c12-78d3-4a26-82ca-1cde7aa1d6f8",
+		CategoryGroupID: groupID,
+		Name:            name,
+	}
+	assert.Equal(t, expected, c)
+}
+
+func TestService_CreateCategoryGroup(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/category_groups"
+	httpmock.RegisterResponder(http.MethodPost, url,
+		func(req *http.Request) (*http.Response, error) {
+			reqModel := struct {
+				CategoryGroup *category.PayloadCategoryGroup `json:"category_group"`
+			}{}
+			err := json.NewDecoder(req.Body).Decode(&reqModel)
+			assert.NoError(t, err)
+			assert.Equal(t, &category.PayloadCategoryGroup{Name: "Fun"}, reqModel.CategoryGroup)
+
+			res := httpmock.NewStringResponse(201, `{
+  "data": {
+    "category_group": {
+      "id": "13419c12-78d3-4818-a5dc-601b2b8a6064",
+      "name": "Fun",
+      "hidden": false,
+      "deleted": false
+    },
+    "server_knowledge": 102
+  }
+}
+		`)
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	g, err := client.Category().CreateCategoryGroup("aa248caa-eed7-4575-a990-717386438d2c",
+		category.PayloadCategoryGroup{Name: "Fun"})
+	assert.NoError(t, err)
+
+	expected := &category.Group{
+		ID:   "13419c12-78d3-4818-a5dc-601b2b8a6064",
+		Name: "Fun",
+	}
+	assert.Equal(t, expected, g)
+}
+
+func TestService_UpdateCategoryGroup(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/category_groups/13419c12-78d3-4818-a5dc-601b2b8a6064"
+	httpmock.RegisterResponder(http.MethodPatch, url,
+		func(req *http.Request) (*http.Response, error) {
+			reqModel := struct {
+				CategoryGroup *category.PayloadCategoryGroup `json:"category_group"`
+			}{}
+			err := json.NewDecoder(req.Body).Decode(&reqModel)
+			assert.NoError(t, err)
+			assert.Equal(t, &category.PayloadCategoryGroup{Name: "Just for Fun"}, reqModel.CategoryGroup)
+
+			res := httpmock.NewStringResponse(200, `{
+  "data": {
+    "category_group": {
+      "id": "13419c12-78d3-4818-a5dc-601b2b8a6064",
+      "name": "Just for Fun",
+      "hidden": false,
+      "deleted": false
+    },
+    "server_knowledge": 103
+  }
+}
+		`)
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	g, err := client.Category().UpdateCategoryGroup("aa248caa-eed7-4575-a990-717386438d2c",
+		"13419c12-78d3-4818-a5dc-601b2b8a6064",
+		category.PayloadCategoryGroup{Name: "Just for Fun"})
+	assert.NoError(t, err)
+
+	expected := &category.Group{
+		ID:   "13419c12-78d3-4818-a5dc-601b2b8a6064",
+		Name: "Just for Fun",
+	}
+	assert.Equal(t, expected, g)
+}
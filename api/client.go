@@ -6,9 +6,12 @@
 // the API services
 package api // import "github.com/brunomvsouza/ynab.go/api"
 
+import "context"
+
 // ClientReader contract for a read only client
 type ClientReader interface {
 	GET(url string, responseModel interface{}) error
+	GETWithContext(ctx context.Context, url string, responseModel interface{}) error
 }
 
 // ClientWriter contract for a write only client
@@ -17,6 +20,11 @@ type ClientWriter interface {
 	PUT(url string, responseModel interface{}, requestBody []byte) error
 	PATCH(url string, responseModel interface{}, requestBody []byte) error
 	DELETE(url string, responseModel interface{}) error
+
+	POSTWithContext(ctx context.Context, url string, responseModel interface{}, requestBody []byte) error
+	PUTWithContext(ctx context.Context, url string, responseModel interface{}, requestBody []byte) error
+	PATCHWithContext(ctx context.Context, url string, responseModel interface{}, requestBody []byte) error
+	DELETEWithContext(ctx context.Context, url string, responseModel interface{}) error
 }
 
 // ClientReaderWriter contract for a read-write client
@@ -0,0 +1,23 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package api
+
+import "context"
+
+type idempotentKey struct{}
+
+// WithIdempotent returns a copy of ctx marking the request it is bound to
+// as idempotent, i.e. safe to be sent more than once with the same effect.
+// Clients may retry idempotent write requests on transient failures.
+func WithIdempotent(ctx context.Context) context.Context {
+	return context.WithValue(ctx, idempotentKey{}, true)
+}
+
+// IsIdempotent tells whether the request bound to ctx was marked as
+// idempotent by WithIdempotent
+func IsIdempotent(ctx context.Context) bool {
+	idempotent, _ := ctx.Value(idempotentKey{}).(bool)
+	return idempotent
+}
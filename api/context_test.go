@@ -0,0 +1,20 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package api_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/brunomvsouza/ynab.go/api"
+)
+
+func TestWithIdempotent(t *testing.T) {
+	ctx := context.Background()
+	assert.False(t, api.IsIdempotent(ctx))
+	assert.True(t, api.IsIdempotent(api.WithIdempotent(ctx)))
+}
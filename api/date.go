@@ -32,12 +32,39 @@ func (d *Date) UnmarshalJSON(b []byte) error {
 	return err
 }
 
-// MarshalJSON parses the expected format for a Date
-func (d *Date) MarshalJSON() ([]byte, error) {
+// MarshalJSON parses the expected format for a Date. It has a value
+// receiver so Date values not addressable when marshaled, e.g. the ones
+// stored in interface values, are formatted as well.
+func (d Date) MarshalJSON() ([]byte, error) {
 	val := d.Format(dateLayout)
 	return []byte(fmt.Sprintf(`"%s"`, val)), nil
 }
 
+// UnmarshalText parses the expected format for a Date, allowing dates
+// to be used as keys of JSON objects
+func (d *Date) UnmarshalText(text []byte) error {
+	date, err := DateFromString(string(text))
+	if err != nil {
+		return err
+	}
+
+	*d = date
+	return nil
+}
+
+// MarshalText formats a Date as expected, allowing dates to be used as
+// keys of JSON objects
+func (d Date) MarshalText() ([]byte, error) {
+	return d.AppendText(nil)
+}
+
+// AppendText appends a Date formatted as expected to b. It shadows the
+// one of the embedded time.Time, preferred over MarshalText by newer
+// versions of encoding/json.
+func (d Date) AppendText(b []byte) ([]byte, error) {
+	return d.AppendFormat(b, dateLayout), nil
+}
+
 // DateFromString creates a new Date from a given string date
 // formatted as dateLayout
 func DateFromString(s string) (Date, error) {
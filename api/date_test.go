@@ -63,6 +63,37 @@ func TestDate_MarshalJSON(t *testing.T) {
 	assert.Equal(t, `{"Date":"2020-01-20"}`, string(buf))
 }
 
+func TestDate_MarshalJSON_value(t *testing.T) {
+	date, err := api.DateFromString("2020-01-20")
+	assert.NoError(t, err)
+
+	buf, err := json.Marshal(date)
+	assert.NoError(t, err)
+	assert.Equal(t, `"2020-01-20"`, string(buf))
+
+	buf, err = json.Marshal(map[string]interface{}{"date": date})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"date":"2020-01-20"}`, string(buf))
+}
+
+func TestDate_mapKey(t *testing.T) {
+	values := map[api.Date]int64{}
+	err := json.Unmarshal([]byte(`{"2020-01-01": 3375, "2021-06-01": 2900}`), &values)
+	assert.NoError(t, err)
+
+	date, err := api.DateFromString("2021-06-01")
+	assert.NoError(t, err)
+	assert.Len(t, values, 2)
+	assert.Equal(t, int64(2900), values[date])
+
+	buf, err := json.Marshal(values)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"2020-01-01":3375,"2021-06-01":2900}`, string(buf))
+
+	err = json.Unmarshal([]byte(`{"2020-01-01T00:00:00Z": 1}`), &values)
+	assert.Error(t, err)
+}
+
 func TestDateFromString(t *testing.T) {
 	table := []struct {
 		InputDate          string
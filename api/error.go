@@ -5,14 +5,84 @@
 package api
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
 )
 
+// Sentinel errors mapped from the error IDs documented by YNAB, meant to be
+// checked with errors.Is
+// https://api.youneedabudget.com/#errors
+var (
+	// ErrBadRequest the request was malformed or had invalid parameters
+	ErrBadRequest = errors.New("api: bad request")
+	// ErrUnauthorized the access token is missing, invalid, revoked or expired
+	ErrUnauthorized = errors.New("api: not authorized")
+	// ErrSubscriptionLapsed the subscription of the user has lapsed
+	ErrSubscriptionLapsed = errors.New("api: subscription lapsed")
+	// ErrTrialExpired the trial of the user has expired
+	ErrTrialExpired = errors.New("api: trial expired")
+	// ErrUnauthorizedScope the access token does not have the scope
+	// required by the request
+	ErrUnauthorizedScope = errors.New("api: unauthorized scope")
+	// ErrDataLimitReached the request would exceed the data limits of the
+	// budget
+	ErrDataLimitReached = errors.New("api: data limit reached")
+	// ErrNotFound the requested resource or endpoint does not exist
+	ErrNotFound = errors.New("api: not found")
+	// ErrConflict the resource cannot be saved because it conflicts with
+	// an existing one
+	ErrConflict = errors.New("api: conflict")
+	// ErrRateLimited the rate limit of the access token was exceeded
+	ErrRateLimited = errors.New("api: too many requests")
+	// ErrInternalServer the API experienced an unexpected error
+	ErrInternalServer = errors.New("api: internal server error")
+	// ErrServiceUnavailable the API is temporarily unavailable
+	ErrServiceUnavailable = errors.New("api: service unavailable")
+)
+
+// errorsByID maps YNAB's documented error IDs to sentinel errors
+var errorsByID = map[string]error{
+	"400":   ErrBadRequest,
+	"401":   ErrUnauthorized,
+	"403.1": ErrSubscriptionLapsed,
+	"403.2": ErrTrialExpired,
+	"403.3": ErrUnauthorizedScope,
+	"403.4": ErrDataLimitReached,
+	"404.1": ErrNotFound,
+	"404.2": ErrNotFound,
+	"409":   ErrConflict,
+	"429":   ErrRateLimited,
+	"500":   ErrInternalServer,
+	"503":   ErrServiceUnavailable,
+}
+
+// errorsByStatusCode maps HTTP status codes to sentinel errors for
+// errors without a documented ID
+var errorsByStatusCode = map[int]error{
+	http.StatusBadRequest:          ErrBadRequest,
+	http.StatusUnauthorized:        ErrUnauthorized,
+	http.StatusNotFound:            ErrNotFound,
+	http.StatusConflict:            ErrConflict,
+	http.StatusTooManyRequests:     ErrRateLimited,
+	http.StatusInternalServerError: ErrInternalServer,
+	http.StatusServiceUnavailable:  ErrServiceUnavailable,
+}
+
 // Error represents an API Error
 type Error struct {
 	ID     string `json:"id"`
 	Name   string `json:"name"`
 	Detail string `json:"detail"`
+
+	// StatusCode the HTTP status code of the response
+	StatusCode int `json:"-"`
+	// Method the HTTP method of the request
+	Method string `json:"-"`
+	// URL the URL of the request
+	URL string `json:"-"`
+	// Body the raw body of the response
+	Body []byte `json:"-"`
 }
 
 // Error returns the string version of the error
@@ -20,3 +90,15 @@ func (e Error) Error() string {
 	return fmt.Sprintf("api: error id=%s name=%s detail=%s",
 		e.ID, e.Name, e.Detail)
 }
+
+// Is tells whether the error matches the target sentinel error,
+// e.g. errors.Is(err, api.ErrNotFound)
+func (e Error) Is(target error) bool {
+	if sentinel, ok := errorsByID[e.ID]; ok {
+		return sentinel == target
+	}
+	if sentinel, ok := errorsByStatusCode[e.StatusCode]; ok {
+		return sentinel == target
+	}
+	return false
+}
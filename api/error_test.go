@@ -0,0 +1,58 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package api_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/brunomvsouza/ynab.go/api"
+)
+
+func TestError_Error(t *testing.T) {
+	err := &api.Error{ID: "404.2", Name: "resource_not_found", Detail: "Resource not found"}
+	assert.EqualError(t, err, "api: error id=404.2 name=resource_not_found detail=Resource not found")
+}
+
+func TestError_Is(t *testing.T) {
+	table := []struct {
+		Input  *api.Error
+		Target error
+	}{
+		{&api.Error{ID: "400"}, api.ErrBadRequest},
+		{&api.Error{ID: "401"}, api.ErrUnauthorized},
+		{&api.Error{ID: "403.1"}, api.ErrSubscriptionLapsed},
+		{&api.Error{ID: "403.2"}, api.ErrTrialExpired},
+		{&api.Error{ID: "403.3"}, api.ErrUnauthorizedScope},
+		{&api.Error{ID: "403.4"}, api.ErrDataLimitReached},
+		{&api.Error{ID: "404.1"}, api.ErrNotFound},
+		{&api.Error{ID: "404.2"}, api.ErrNotFound},
+		{&api.Error{ID: "409"}, api.ErrConflict},
+		{&api.Error{ID: "429"}, api.ErrRateLimited},
+		{&api.Error{ID: "500"}, api.ErrInternalServer},
+		{&api.Error{ID: "503"}, api.ErrServiceUnavailable},
+		{&api.Error{ID: "404", StatusCode: http.StatusNotFound}, api.ErrNotFound},
+		{&api.Error{ID: "foo", StatusCode: http.StatusConflict}, api.ErrConflict},
+	}
+
+	for _, test := range table {
+		assert.True(t, errors.Is(test.Input, test.Target), test.Input.ID)
+		assert.True(t, errors.Is(fmt.Errorf("wrapped: %w", test.Input), test.Target), test.Input.ID)
+	}
+
+	assert.False(t, errors.Is(&api.Error{ID: "404.1"}, api.ErrConflict))
+	assert.False(t, errors.Is(&api.Error{ID: "403.1", StatusCode: http.StatusForbidden}, api.ErrUnauthorized))
+	assert.False(t, errors.Is(&api.Error{ID: "418", StatusCode: http.StatusTeapot}, api.ErrBadRequest))
+}
+
+func TestRateLimitError_Is(t *testing.T) {
+	err := &api.RateLimitError{}
+	assert.True(t, errors.Is(err, api.ErrRateLimited))
+	assert.False(t, errors.Is(err, api.ErrNotFound))
+}
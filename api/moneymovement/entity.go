@@ -0,0 +1,64 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+// Package moneymovement implements money movement entities and services
+package moneymovement // import "github.com/brunomvsouza/ynab.go/api/moneymovement"
+
+import (
+	"time"
+
+	"github.com/brunomvsouza/ynab.go/api"
+)
+
+// MoneyMovement represents money being assigned, moved or removed between
+// categories of a budget
+type MoneyMovement struct {
+	ID string `json:"id"`
+	// Amount The amount moved in milliunits format
+	Amount int64 `json:"amount"`
+
+	// Month The month the money was moved in
+	Month *api.Date `json:"month"`
+	// MovedAt When the money was moved
+	MovedAt *time.Time `json:"moved_at"`
+	Note    *string    `json:"note"`
+	// MoneyMovementGroupID The group the movement belongs to
+	MoneyMovementGroupID *string `json:"money_movement_group_id"`
+	// PerformedByUserID The user who moved the money
+	PerformedByUserID *string `json:"performed_by_user_id"`
+	// FromCategoryID The category the money was moved from, nil when the
+	// money came from Ready to Assign
+	FromCategoryID *string `json:"from_category_id"`
+	// ToCategoryID The category the money was moved to, nil when the money
+	// went back to Ready to Assign
+	ToCategoryID *string `json:"to_category_id"`
+}
+
+// Group represents a group of money movements performed together, e.g.
+// by a single auto-assign
+type Group struct {
+	ID string `json:"id"`
+	// GroupCreatedAt When the group was created
+	GroupCreatedAt time.Time `json:"group_created_at"`
+	// Month The month the money was moved in
+	Month api.Date `json:"month"`
+
+	Note *string `json:"note"`
+	// PerformedByUserID The user who moved the money
+	PerformedByUserID *string `json:"performed_by_user_id"`
+}
+
+// SearchResultSnapshot represents a versioned snapshot for a money
+// movement search
+type SearchResultSnapshot struct {
+	MoneyMovements  []*MoneyMovement
+	ServerKnowledge uint64
+}
+
+// GroupSearchResultSnapshot represents a versioned snapshot for a money
+// movement group search
+type GroupSearchResultSnapshot struct {
+	Groups          []*Group
+	ServerKnowledge uint64
+}
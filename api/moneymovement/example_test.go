@@ -0,0 +1,47 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package moneymovement_test
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/brunomvsouza/ynab.go"
+	"github.com/brunomvsouza/ynab.go/api"
+)
+
+func ExampleService_GetMoneyMovements() {
+	c := ynab.NewClient("<valid_ynab_access_token>")
+	snapshot, _ := c.MoneyMovement().GetMoneyMovements("<valid_budget_id>")
+	fmt.Println(reflect.TypeOf(snapshot))
+
+	// Output: *moneymovement.SearchResultSnapshot
+}
+
+func ExampleService_GetMoneyMovementsForMonth() {
+	c := ynab.NewClient("<valid_ynab_access_token>")
+	d, _ := api.DateFromString("2010-01-01")
+	snapshot, _ := c.MoneyMovement().GetMoneyMovementsForMonth("<valid_budget_id>", d)
+	fmt.Println(reflect.TypeOf(snapshot))
+
+	// Output: *moneymovement.SearchResultSnapshot
+}
+
+func ExampleService_GetMoneyMovementGroups() {
+	c := ynab.NewClient("<valid_ynab_access_token>")
+	snapshot, _ := c.MoneyMovement().GetMoneyMovementGroups("<valid_budget_id>")
+	fmt.Println(reflect.TypeOf(snapshot))
+
+	// Output: *moneymovement.GroupSearchResultSnapshot
+}
+
+func ExampleService_GetMoneyMovementGroupsForMonth() {
+	c := ynab.NewClient("<valid_ynab_access_token>")
+	d, _ := api.DateFromString("2010-01-01")
+	snapshot, _ := c.MoneyMovement().GetMoneyMovementGroupsForMonth("<valid_budget_id>", d)
+	fmt.Println(reflect.TypeOf(snapshot))
+
+	// Output: *moneymovement.GroupSearchResultSnapshot
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package moneymovement
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/brunomvsouza/ynab.go/api"
+)
+
+// NewService facilitates the creation of a new money movement service instance
+func NewService(c api.ClientReader) *Service {
+	return &Service{c}
+}
+
+// Service wraps YNAB money movement API endpoints
+type Service struct {
+	c api.ClientReader
+}
+
+// GetMoneyMovements fetches the list of money movements from a budget
+// https://api.youneedabudget.com/v1#/Money_Movements/getMoneyMovements
+func (s *Service) GetMoneyMovements(budgetID string) (*SearchResultSnapshot, error) {
+	return s.GetMoneyMovementsWithContext(context.Background(), budgetID)
+}
+
+// GetMoneyMovementsWithContext fetches the list of money movements from
+// a budget bound to ctx
+// https://api.youneedabudget.com/v1#/Money_Movements/getMoneyMovements
+func (s *Service) GetMoneyMovementsWithContext(ctx context.Context,
+	budgetID string) (*SearchResultSnapshot, error) {
+
+	url := fmt.Sprintf("/budgets/%s/money_movements", budgetID)
+	return s.getMoneyMovements(ctx, url)
+}
+
+// GetMoneyMovementsForMonth fetches the list of money movements of a
+// month from a budget
+// https://api.youneedabudget.com/v1#/Money_Movements/getMoneyMovementsByMonth
+func (s *Service) GetMoneyMovementsForMonth(budgetID string,
+	month api.Date) (*SearchResultSnapshot, error) {
+
+	return s.GetMoneyMovementsForMonthWithContext(context.Background(), budgetID, month)
+}
+
+// GetMoneyMovementsForMonthWithContext fetches the list of money movements
+// of a month from a budget bound to ctx
+// https://api.youneedabudget.com/v1#/Money_Movements/getMoneyMovementsByMonth
+func (s *Service) GetMoneyMovementsForMonthWithContext(ctx context.Context, budgetID string,
+	month api.Date) (*SearchResultSnapshot, error) {
+
+	url := fmt.Sprintf("/budgets/%s/months/%s/money_movements", budgetID,
+		api.DateFormat(month))
+	return s.getMoneyMovements(ctx, url)
+}
+
+func (s *Service) getMoneyMovements(ctx context.Context, url string) (*SearchResultSnapshot, error) {
+	resModel := struct {
+		Data struct {
+			MoneyMovements  []*MoneyMovement `json:"money_movements"`
+			ServerKnowledge uint64           `json:"server_knowledge"`
+		} `json:"data"`
+	}{}
+
+	if err := s.c.GETWithContext(ctx, url, &resModel); err != nil {
+		return nil, err
+	}
+	return &SearchResultSnapshot{
+		MoneyMovements:  resModel.Data.MoneyMovements,
+		ServerKnowledge: resModel.Data.ServerKnowledge,
+	}, nil
+}
+
+// GetMoneyMovementGroups fetches the list of money movement groups from a budget
+// https://api.youneedabudget.com/v1#/Money_Movements/getMoneyMovementGroups
+func (s *Service) GetMoneyMovementGroups(budgetID string) (*GroupSearchResultSnapshot, error) {
+	return s.GetMoneyMovementGroupsWithContext(context.Background(), budgetID)
+}
+
+// GetMoneyMovementGroupsWithContext fetches the list of money movement
+// groups from a budget bound to ctx
+// https://api.youneedabudget.com/v1#/Money_Movements/getMoneyMovementGroups
+func (s *Service) GetMoneyMovementGroupsWithContext(ctx context.Context,
+	budgetID string) (*GroupSearchResultSnapshot, error) {
+
+	url := fmt.Sprintf("/budgets/%s/money_movement_groups", budgetID)
+	return s.getMoneyMovementGroups(ctx, url)
+}
+
+// GetMoneyMovementGroupsForMonth fetches the list of money movement groups
+// of a month from a budget
+// https://api.youneedabudget.com/v1#/Money_Movements/getMoneyMovementGroupsByMonth
+func (s *Service) GetMoneyMovementGroupsForMonth(budgetID string,
+	month api.Date) (*GroupSearchResultSnapshot, error) {
+
+	return s.GetMoneyMovementGroupsForMonthWithContext(context.Background(), budgetID, month)
+}
+
+// GetMoneyMovementGroupsForMonthWithContext fetches the list of money
+// movement groups of a month from a budget bound to ctx
+// https://api.youneedabudget.com/v1#/Money_Movements/getMoneyMovementGroupsByMonth
+func (s *Service) GetMoneyMovementGroupsForMonthWithContext(ctx context.Context,
+	budgetID string, month api.Date) (*GroupSearchResultSnapshot, error) {
+
+	url := fmt.Sprintf("/budgets/%s/months/%s/money_movement_groups", budgetID,
+		api.DateFormat(month))
+	return s.getMoneyMovementGroups(ctx, url)
+}
+
+func (s *Service) getMoneyMovementGroups(ctx context.Context,
+	url string) (*GroupSearchResultSnapshot, error) {
+
+	resModel := struct {
+		Data struct {
+			Groups          []*Group `json:"money_movement_groups"`
+			ServerKnowledge uint64   `json:"server_knowledge"`
+		} `json:"data"`
+	}{}
+
+	if err := s.c.GETWithContext(ctx, url, &resModel); err != nil {
+		return nil, err
+	}
+	return &GroupSearchResultSnapshot{
+		Groups:          resModel.Data.Groups,
+		ServerKnowledge: resModel.Data.ServerKnowledge,
+	}, nil
+}
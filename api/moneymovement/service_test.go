@@ -0,0 +1,188 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package moneymovement_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+
+	"github.com/brunomvsouza/ynab.go"
+	"github.com/brunomvsouza/ynab.go/api"
+	"github.com/brunomvsouza/ynab.go/api/moneymovement"
+)
+
+const moneyMovementsResponse = `{
+  "data": {
+    "money_movements": [
+      {
+        "id": "5e2a4a3c-4f1b-4c3e-9d1a-2b3c4d5e6f70",
+        "month": "2018-11-01",
+        "moved_at": "2018-11-05T10:15:00Z",
+        "note": "rebalancing",
+        "money_movement_group_id": "c8a1b2c3-d4e5-4f60-8172-93a4b5c6d7e8",
+        "performed_by_user_id": "aa248caa-eed7-4575-a990-717386438d2c",
+        "from_category_id": "13419c12-78d3-4a26-82ca-1cde7aa1d6f8",
+        "to_category_id": "f3cc4f55-312a-4bcd-89c4-db34379cb1dc",
+        "amount": 25000
+      }
+    ],
+    "server_knowledge": 42
+  }
+}`
+
+func expectedMoneyMovements(t *testing.T) *moneymovement.SearchResultSnapshot {
+	month, err := api.DateFromString("2018-11-01")
+	assert.NoError(t, err)
+
+	var (
+		movedAt        = time.Date(2018, 11, 5, 10, 15, 0, 0, time.UTC)
+		note           = "rebalancing"
+		groupID        = "c8a1b2c3-d4e5-4f60-8172-93a4b5c6d7e8"
+		userID         = "aa248caa-eed7-4575-a990-717386438d2c"
+		fromCategoryID = "13419c12-78d3-4a26-82ca-1cde7aa1d6f8"
+		toCategoryID   = "f3cc4f55-312a-4bcd-89c4-db34379cb1dc"
+	)
+
+	return &moneymovement.SearchResultSnapshot{
+		MoneyMovements: []*moneymovement.MoneyMovement{
+			{
+				ID:                   "5e2a4a3c-4f1b-4c3e-9d1a-2b3c4d5e6f70",
+				Amount:               int64(25000),
+				Month:                &month,
+				MovedAt:              &movedAt,
+				Note:                 &note,
+				MoneyMovementGroupID: &groupID,
+				PerformedByUserID:    &userID,
+				FromCategoryID:       &fromCategoryID,
+				ToCategoryID:         &toCategoryID,
+			},
+		},
+		ServerKnowledge: uint64(42),
+	}
+}
+
+const moneyMovementGroupsResponse = `{
+  "data": {
+    "money_movement_groups": [
+      {
+        "id": "c8a1b2c3-d4e5-4f60-8172-93a4b5c6d7e8",
+        "group_created_at": "2018-11-05T10:15:00Z",
+        "month": "2018-11-01",
+        "note": null,
+        "performed_by_user_id": "aa248caa-eed7-4575-a990-717386438d2c"
+      }
+    ],
+    "server_knowledge": 42
+  }
+}`
+
+func expectedMoneyMovementGroups(t *testing.T) *moneymovement.GroupSearchResultSnapshot {
+	month, err := api.DateFromString("2018-11-01")
+	assert.NoError(t, err)
+
+	userID := "aa248caa-eed7-4575-a990-717386438d2c"
+
+	return &moneymovement.GroupSearchResultSnapshot{
+		Groups: []*moneymovement.Group{
+			{
+				ID:                "c8a1b2c3-d4e5-4f60-8172-93a4b5c6d7e8",
+				GroupCreatedAt:    time.Date(2018, 11, 5, 10, 15, 0, 0, time.UTC),
+				Month:             month,
+				PerformedByUserID: &userID,
+			},
+		},
+		ServerKnowledge: uint64(42),
+	}
+}
+
+func TestService_GetMoneyMovements(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/money_movements"
+	httpmock.RegisterResponder(http.MethodGet, url,
+		httpmock.NewStringResponder(200, moneyMovementsResponse))
+
+	client := ynab.NewClient("")
+	snapshot, err := client.MoneyMovement().GetMoneyMovements("aa248caa-eed7-4575-a990-717386438d2c")
+	assert.NoError(t, err)
+	assert.Equal(t, expectedMoneyMovements(t), snapshot)
+}
+
+func TestService_GetMoneyMovementsForMonth(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/months/2018-11-01/money_movements"
+	httpmock.RegisterResponder(http.MethodGet, url,
+		httpmock.NewStringResponder(200, moneyMovementsResponse))
+
+	month, err := api.DateFromString("2018-11-01")
+	assert.NoError(t, err)
+
+	client := ynab.NewClient("")
+	snapshot, err := client.MoneyMovement().GetMoneyMovementsForMonth(
+		"aa248caa-eed7-4575-a990-717386438d2c", month)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedMoneyMovements(t), snapshot)
+}
+
+func TestService_GetMoneyMovementGroups(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/money_movement_groups"
+	httpmock.RegisterResponder(http.MethodGet, url,
+		httpmock.NewStringResponder(200, moneyMovementGroupsResponse))
+
+	client := ynab.NewClient("")
+	snapshot, err := client.MoneyMovement().GetMoneyMovementGroups("aa248caa-eed7-4575-a990-717386438d2c")
+	assert.NoError(t, err)
+	assert.Equal(t, expectedMoneyMovementGroups(t), snapshot)
+}
+
+func TestService_GetMoneyMovementGroupsForMonth(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/months/2018-11-01/money_movement_groups"
+	httpmock.RegisterResponder(http.MethodGet, url,
+		httpmock.NewStringResponder(200, moneyMovementGroupsResponse))
+
+	month, err := api.DateFromString("2018-11-01")
+	assert.NoError(t, err)
+
+	client := ynab.NewClient("")
+	snapshot, err := client.MoneyMovement().GetMoneyMovementGroupsForMonth(
+		"aa248caa-eed7-4575-a990-717386438d2c", month)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedMoneyMovementGroups(t), snapshot)
+}
+
+func TestService_GetMoneyMovementsWithContext(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "value")
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/money_movements"
+	httpmock.RegisterResponder(http.MethodGet, url,
+		func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "value", req.Context().Value(ctxKey{}))
+			return httpmock.NewStringResponse(200, moneyMovementsResponse), nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	_, err := client.MoneyMovement().GetMoneyMovementsWithContext(ctx,
+		"aa248caa-eed7-4575-a990-717386438d2c")
+	assert.NoError(t, err)
+}
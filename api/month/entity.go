@@ -17,6 +17,8 @@ import (
 type Month struct {
 	Month      api.Date             `json:"month"`
 	Categories []*category.Category `json:"categories"`
+	// Deleted Deleted months will only be included in delta requests
+	Deleted bool `json:"deleted"`
 
 	Note         *string `json:"note"`
 	ToBeBudgeted *int64  `json:"to_be_budgeted"`
@@ -38,6 +40,8 @@ type Month struct {
 // amounts are available.
 type Summary struct {
 	Month api.Date `json:"month"`
+	// Deleted Deleted months will only be included in delta requests
+	Deleted bool `json:"deleted"`
 
 	Note         *string `json:"note"`
 	ToBeBudgeted *int64  `json:"to_be_budgeted"`
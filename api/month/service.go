@@ -5,6 +5,7 @@
 package month
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/brunomvsouza/ynab.go/api"
@@ -23,6 +24,14 @@ type Service struct {
 // GetMonths fetches the list of months from a budget
 // https://api.youneedabudget.com/v1#/Months/getBudgetMonths
 func (s *Service) GetMonths(budgetID string, f *api.Filter) (*SearchResultSnapshot, error) {
+	return s.GetMonthsWithContext(context.Background(), budgetID, f)
+}
+
+// GetMonthsWithContext fetches the list of months from a budget bound to ctx
+// https://api.youneedabudget.com/v1#/Months/getBudgetMonths
+func (s *Service) GetMonthsWithContext(ctx context.Context, budgetID string,
+	f *api.Filter) (*SearchResultSnapshot, error) {
+
 	resModel := struct {
 		Data struct {
 			Months          []*Summary `json:"months"`
@@ -35,7 +44,7 @@ func (s *Service) GetMonths(budgetID string, f *api.Filter) (*SearchResultSnapsh
 		url = fmt.Sprintf("%s?%s", url, f.ToQuery())
 	}
 
-	if err := s.c.GET(url, &resModel); err != nil {
+	if err := s.c.GETWithContext(ctx, url, &resModel); err != nil {
 		return nil, err
 	}
 	return &SearchResultSnapshot{
@@ -47,6 +56,14 @@ func (s *Service) GetMonths(budgetID string, f *api.Filter) (*SearchResultSnapsh
 // GetMonth fetches a specific month from a budget
 // https://api.youneedabudget.com/v1#/Months/getBudgetMonth
 func (s *Service) GetMonth(budgetID string, month api.Date) (*Month, error) {
+	return s.GetMonthWithContext(context.Background(), budgetID, month)
+}
+
+// GetMonthWithContext fetches a specific month from a budget bound to ctx
+// https://api.youneedabudget.com/v1#/Months/getBudgetMonth
+func (s *Service) GetMonthWithContext(ctx context.Context, budgetID string,
+	month api.Date) (*Month, error) {
+
 	resModel := struct {
 		Data struct {
 			Month *Month `json:"month"`
@@ -55,7 +72,7 @@ func (s *Service) GetMonth(budgetID string, month api.Date) (*Month, error) {
 
 	url := fmt.Sprintf("/budgets/%s/months/%s", budgetID,
 		api.DateFormat(month))
-	if err := s.c.GET(url, &resModel); err != nil {
+	if err := s.c.GETWithContext(ctx, url, &resModel); err != nil {
 		return nil, err
 	}
 	return resModel.Data.Month, nil
@@ -5,6 +5,7 @@
 package month_test
 
 import (
+	"context"
 	"net/http"
 	"testing"
 
@@ -13,6 +14,7 @@ import (
 
 	"github.com/brunomvsouza/ynab.go"
 	"github.com/brunomvsouza/ynab.go/api"
+	"github.com/brunomvsouza/ynab.go/api/month"
 )
 
 func TestService_GetMonths(t *testing.T) {
@@ -115,3 +117,40 @@ func TestService_GetMonth(t *testing.T) {
 	assert.Equal(t, &expectedActivity, m.Activity)
 	assert.Nil(t, m.Note)
 }
+
+func TestService_GetMonthWithContext(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "value")
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/months/2017-10-01"
+	httpmock.RegisterResponder(http.MethodGet, url,
+		func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "value", req.Context().Value(ctxKey{}))
+			res := httpmock.NewStringResponse(200, `{
+  "data": {
+    "month": {
+			"month": "2017-10-01",
+			"note": null
+		}
+	}
+}
+		`)
+			return res, nil
+		},
+	)
+
+	date, err := api.DateFromString("2017-10-01")
+	assert.NoError(t, err)
+
+	client := ynab.NewClient("")
+	m, err := client.Month().GetMonthWithContext(ctx, "aa248caa-eed7-4575-a990-717386438d2c", date)
+	assert.NoError(t, err)
+
+	expected := &month.Month{
+		Month: date,
+	}
+	assert.Equal(t, expected, m)
+}
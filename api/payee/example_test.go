@@ -11,6 +11,7 @@ import (
 	"github.com/brunomvsouza/ynab.go/api"
 
 	"github.com/brunomvsouza/ynab.go"
+	"github.com/brunomvsouza/ynab.go/api/payee"
 )
 
 func ExampleService_GetPayee() {
@@ -30,6 +31,15 @@ func ExampleService_GetPayees() {
 	// Output: *payee.SearchResultSnapshot
 }
 
+func ExampleService_UpdatePayee() {
+	c := ynab.NewClient("<valid_ynab_access_token>")
+	p := payee.PayloadPayee{Name: "Amazon"}
+	payee, _ := c.Payee().UpdatePayee("<valid_budget_id>", "<valid_payee_id>", p)
+	fmt.Println(reflect.TypeOf(payee))
+
+	// Output: *payee.Payee
+}
+
 func ExampleService_GetPayeeLocation() {
 	c := ynab.NewClient("<valid_ynab_access_token>")
 	l, _ := c.Payee().GetPayeeLocation("<valid_budget_id>", "<valid_payee_location_id>")
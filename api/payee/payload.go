@@ -0,0 +1,11 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package payee
+
+// PayloadPayee is the payload contract for updating a payee
+type PayloadPayee struct {
+	// Name The name of the payee. The name must be a maximum of 500 characters.
+	Name string `json:"name"`
+}
@@ -5,24 +5,34 @@
 package payee
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/brunomvsouza/ynab.go/api"
 )
 
 // NewService facilitates the creation of a new payee service instance
-func NewService(c api.ClientReader) *Service {
+func NewService(c api.ClientReaderWriter) *Service {
 	return &Service{c}
 }
 
 // Service wraps YNAB payee API endpoints
 type Service struct {
-	c api.ClientReader
+	c api.ClientReaderWriter
 }
 
 // GetPayees fetches the list of payees from a budget
 // https://api.youneedabudget.com/v1#/Payees/getPayees
 func (s *Service) GetPayees(budgetID string, f *api.Filter) (*SearchResultSnapshot, error) {
+	return s.GetPayeesWithContext(context.Background(), budgetID, f)
+}
+
+// GetPayeesWithContext fetches the list of payees from a budget bound to ctx
+// https://api.youneedabudget.com/v1#/Payees/getPayees
+func (s *Service) GetPayeesWithContext(ctx context.Context, budgetID string,
+	f *api.Filter) (*SearchResultSnapshot, error) {
+
 	resModel := struct {
 		Data struct {
 			Payees          []*Payee `json:"payees"`
@@ -35,7 +45,7 @@ func (s *Service) GetPayees(budgetID string, f *api.Filter) (*SearchResultSnapsh
 		url = fmt.Sprintf("%s?%s", url, f.ToQuery())
 	}
 
-	if err := s.c.GET(url, &resModel); err != nil {
+	if err := s.c.GETWithContext(ctx, url, &resModel); err != nil {
 		return nil, err
 	}
 	return &SearchResultSnapshot{
@@ -47,6 +57,49 @@ func (s *Service) GetPayees(budgetID string, f *api.Filter) (*SearchResultSnapsh
 // GetPayee fetches a specific payee from a budget
 // https://api.youneedabudget.com/v1#/Payees/getPayeeById
 func (s *Service) GetPayee(budgetID, payeeID string) (*Payee, error) {
+	return s.GetPayeeWithContext(context.Background(), budgetID, payeeID)
+}
+
+// GetPayeeWithContext fetches a specific payee from a budget bound to ctx
+// https://api.youneedabudget.com/v1#/Payees/getPayeeById
+func (s *Service) GetPayeeWithContext(ctx context.Context, budgetID,
+	payeeID string) (*Payee, error) {
+
+	resModel := struct {
+		Data struct {
+			Payee *Payee `json:"payee"`
+		} `json:"data"`
+	}{}
+
+	url := fmt.Sprintf("/budgets/%s/payees/%s", budgetID, payeeID)
+	if err := s.c.GETWithContext(ctx, url, &resModel); err != nil {
+		return nil, err
+	}
+	return resModel.Data.Payee, nil
+}
+
+// UpdatePayee updates a payee of a budget, e.g. to rename it
+// https://api.youneedabudget.com/v1#/Payees/updatePayee
+func (s *Service) UpdatePayee(budgetID, payeeID string, p PayloadPayee) (*Payee, error) {
+	return s.UpdatePayeeWithContext(context.Background(), budgetID, payeeID, p)
+}
+
+// UpdatePayeeWithContext updates a payee of a budget bound to ctx
+// https://api.youneedabudget.com/v1#/Payees/updatePayee
+func (s *Service) UpdatePayeeWithContext(ctx context.Context, budgetID,
+	payeeID string, p PayloadPayee) (*Payee, error) {
+
+	payload := struct {
+		Payee *PayloadPayee `json:"payee"`
+	}{
+		&p,
+	}
+
+	buf, err := json.Marshal(&payload)
+	if err != nil {
+		return nil, err
+	}
+
 	resModel := struct {
 		Data struct {
 			Payee *Payee `json:"payee"`
@@ -54,7 +107,7 @@ func (s *Service) GetPayee(budgetID, payeeID string) (*Payee, error) {
 	}{}
 
 	url := fmt.Sprintf("/budgets/%s/payees/%s", budgetID, payeeID)
-	if err := s.c.GET(url, &resModel); err != nil {
+	if err := s.c.PATCHWithContext(ctx, url, &resModel, buf); err != nil {
 		return nil, err
 	}
 	return resModel.Data.Payee, nil
@@ -63,6 +116,15 @@ func (s *Service) GetPayee(budgetID, payeeID string) (*Payee, error) {
 // GetPayeeLocations fetches the list of payee locations from a budget
 // https://api.youneedabudget.com/v1#/Payee_Locations/getPayeeLocations
 func (s *Service) GetPayeeLocations(budgetID string) ([]*Location, error) {
+	return s.GetPayeeLocationsWithContext(context.Background(), budgetID)
+}
+
+// GetPayeeLocationsWithContext fetches the list of payee locations from
+// a budget bound to ctx
+// https://api.youneedabudget.com/v1#/Payee_Locations/getPayeeLocations
+func (s *Service) GetPayeeLocationsWithContext(ctx context.Context,
+	budgetID string) ([]*Location, error) {
+
 	resModel := struct {
 		Data struct {
 			PayeeLocations []*Location `json:"payee_locations"`
@@ -70,7 +132,7 @@ func (s *Service) GetPayeeLocations(budgetID string) ([]*Location, error) {
 	}{}
 
 	url := fmt.Sprintf("/budgets/%s/payee_locations", budgetID)
-	if err := s.c.GET(url, &resModel); err != nil {
+	if err := s.c.GETWithContext(ctx, url, &resModel); err != nil {
 		return nil, err
 	}
 	return resModel.Data.PayeeLocations, nil
@@ -79,6 +141,15 @@ func (s *Service) GetPayeeLocations(budgetID string) ([]*Location, error) {
 // GetPayeeLocation fetches a specific payee location from a budget
 // https://api.youneedabudget.com/v1#/Payee_Locations/getPayeeLocationById
 func (s *Service) GetPayeeLocation(budgetID, payeeLocationID string) (*Location, error) {
+	return s.GetPayeeLocationWithContext(context.Background(), budgetID, payeeLocationID)
+}
+
+// GetPayeeLocationWithContext fetches a specific payee location from
+// a budget bound to ctx
+// https://api.youneedabudget.com/v1#/Payee_Locations/getPayeeLocationById
+func (s *Service) GetPayeeLocationWithContext(ctx context.Context, budgetID,
+	payeeLocationID string) (*Location, error) {
+
 	resModel := struct {
 		Data struct {
 			PayeeLocation *Location `json:"payee_location"`
@@ -86,7 +157,7 @@ func (s *Service) GetPayeeLocation(budgetID, payeeLocationID string) (*Location,
 	}{}
 
 	url := fmt.Sprintf("/budgets/%s/payee_locations/%s", budgetID, payeeLocationID)
-	if err := s.c.GET(url, &resModel); err != nil {
+	if err := s.c.GETWithContext(ctx, url, &resModel); err != nil {
 		return nil, err
 	}
 	return resModel.Data.PayeeLocation, nil
@@ -95,6 +166,15 @@ func (s *Service) GetPayeeLocation(budgetID, payeeLocationID string) (*Location,
 // GetPayeeLocationsByPayee fetches the list of locations of a specific payee from a budget
 // https://api.youneedabudget.com/v1#/Payee_Locations/getPayeeLocationsByPayee
 func (s *Service) GetPayeeLocationsByPayee(budgetID, payeeID string) ([]*Location, error) {
+	return s.GetPayeeLocationsByPayeeWithContext(context.Background(), budgetID, payeeID)
+}
+
+// GetPayeeLocationsByPayeeWithContext fetches the list of locations of
+// a specific payee from a budget bound to ctx
+// https://api.youneedabudget.com/v1#/Payee_Locations/getPayeeLocationsByPayee
+func (s *Service) GetPayeeLocationsByPayeeWithContext(ctx context.Context, budgetID,
+	payeeID string) ([]*Location, error) {
+
 	resModel := struct {
 		Data struct {
 			PayeeLocations []*Location `json:"payee_locations"`
@@ -102,7 +182,7 @@ func (s *Service) GetPayeeLocationsByPayee(budgetID, payeeID string) ([]*Locatio
 	}{}
 
 	url := fmt.Sprintf("/budgets/%s/payees/%s/payee_locations", budgetID, payeeID)
-	if err := s.c.GET(url, &resModel); err != nil {
+	if err := s.c.GETWithContext(ctx, url, &resModel); err != nil {
 		return nil, err
 	}
 	return resModel.Data.PayeeLocations, nil
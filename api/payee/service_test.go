@@ -5,6 +5,8 @@
 package payee_test
 
 import (
+	"context"
+	"encoding/json"
 	"net/http"
 	"strconv"
 	"testing"
@@ -100,6 +102,53 @@ func TestService_GetPayee(t *testing.T) {
 	assert.Equal(t, expected, p)
 }
 
+func TestService_UpdatePayee(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/payees/34e88373-ef48-4386-9ab3-7f86c2a8988f"
+	httpmock.RegisterResponder(http.MethodPatch, url,
+		func(req *http.Request) (*http.Response, error) {
+			reqModel := struct {
+				Payee *payee.PayloadPayee `json:"payee"`
+			}{}
+			err := json.NewDecoder(req.Body).Decode(&reqModel)
+			assert.NoError(t, err)
+			assert.Equal(t, &payee.PayloadPayee{Name: "Amazon"}, reqModel.Payee)
+
+			res := httpmock.NewStringResponse(200, `{
+  "data": {
+		"payee": {
+			"id": "34e88373-ef48-4386-9ab3-7f86c2a8988f",
+			"name": "Amazon",
+			"transfer_account_id": null,
+			"deleted": false
+		},
+		"server_knowledge": 12
+	}
+}
+		`)
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	p, err := client.Payee().UpdatePayee(
+		"aa248caa-eed7-4575-a990-717386438d2c",
+		"34e88373-ef48-4386-9ab3-7f86c2a8988f",
+		payee.PayloadPayee{Name: "Amazon"},
+	)
+	assert.NoError(t, err)
+
+	expected := &payee.Payee{
+		ID:      "34e88373-ef48-4386-9ab3-7f86c2a8988f",
+		Name:    "Amazon",
+		Deleted: false,
+	}
+
+	assert.Equal(t, expected, p)
+}
+
 func TestService_GetPayeeLocations(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
@@ -245,3 +294,41 @@ func TestService_GetPayeeLocationsByPayee(t *testing.T) {
 
 	assert.Equal(t, expected, locations)
 }
+
+func TestService_GetPayeeWithContext(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "value")
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/payees/34e88373-ef48-4386-9ab3-7f86c2a8988f"
+	httpmock.RegisterResponder(http.MethodGet, url,
+		func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "value", req.Context().Value(ctxKey{}))
+			res := httpmock.NewStringResponse(200, `{
+  "data": {
+		"payee": {
+			"id": "34e88373-ef48-4386-9ab3-7f86c2a8988f",
+			"name": "Supermarket"
+		}
+	}
+}
+		`)
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	p, err := client.Payee().GetPayeeWithContext(ctx,
+		"aa248caa-eed7-4575-a990-717386438d2c",
+		"34e88373-ef48-4386-9ab3-7f86c2a8988f",
+	)
+	assert.NoError(t, err)
+
+	expected := &payee.Payee{
+		ID:   "34e88373-ef48-4386-9ab3-7f86c2a8988f",
+		Name: "Supermarket",
+	}
+	assert.Equal(t, expected, p)
+}
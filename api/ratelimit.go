@@ -0,0 +1,102 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package api
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RateLimitWindow the period YNAB enforces the request quota over
+const RateLimitWindow = time.Hour
+
+// RateLimitReporter is implemented by clients keeping track of the
+// request quota usage of the access token
+type RateLimitReporter interface {
+	RateLimit() (used, limit int)
+}
+
+// RateLimit represents the request quota usage of an access token as
+// reported by the YNAB API on the X-Rate-Limit response header
+// https://api.youneedabudget.com/#rate-limiting
+type RateLimit struct {
+	// Used the number of requests made in the current window
+	Used int
+	// Limit the maximum number of requests allowed in a window
+	Limit int
+}
+
+// ParseRateLimit parses the value of a X-Rate-Limit header formatted
+// as "used/limit", e.g. "36/200"
+func ParseRateLimit(s string) (RateLimit, error) {
+	parts := strings.Split(strings.TrimSpace(s), "/")
+	if len(parts) != 2 {
+		return RateLimit{}, fmt.Errorf("api: invalid rate limit %q", s)
+	}
+
+	used, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return RateLimit{}, fmt.Errorf("api: invalid rate limit %q: %w", s, err)
+	}
+	limit, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return RateLimit{}, fmt.Errorf("api: invalid rate limit %q: %w", s, err)
+	}
+
+	return RateLimit{Used: used, Limit: limit}, nil
+}
+
+// Remaining returns the number of requests still available in the
+// current window
+func (r RateLimit) Remaining() int {
+	if r.Used >= r.Limit {
+		return 0
+	}
+	return r.Limit - r.Used
+}
+
+// Exceeded tells whether the quota of the current window is exhausted
+func (r RateLimit) Exceeded() bool {
+	return r.Limit > 0 && r.Used >= r.Limit
+}
+
+// RateLimitError represents a request refused because the rate limit
+// of the access token was exceeded. It is returned either when the API
+// replies with HTTP 429 or when the client refuses to send a request
+// because the known quota is already exhausted.
+type RateLimitError struct {
+	RateLimit RateLimit
+	// RetryAfter how long to wait before sending new requests, zero
+	// when unknown
+	RetryAfter time.Duration
+	// Err the error returned by the API, nil when the request was
+	// not sent
+	Err *Error
+}
+
+// Error returns the string version of the error
+func (e *RateLimitError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("api: rate limit exceeded used=%d limit=%d retry_after=%s: %s",
+			e.RateLimit.Used, e.RateLimit.Limit, e.RetryAfter, e.Err.Error())
+	}
+	return fmt.Sprintf("api: rate limit exceeded used=%d limit=%d retry_after=%s",
+		e.RateLimit.Used, e.RateLimit.Limit, e.RetryAfter)
+}
+
+// Unwrap returns the error returned by the API, if any
+func (e *RateLimitError) Unwrap() error {
+	if e.Err == nil {
+		return nil
+	}
+	return e.Err
+}
+
+// Is tells whether the target is ErrRateLimited
+func (e *RateLimitError) Is(target error) bool {
+	return target == ErrRateLimited
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package api_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/brunomvsouza/ynab.go/api"
+)
+
+func TestParseRateLimit(t *testing.T) {
+	table := []struct {
+		Input       string
+		Output      api.RateLimit
+		OutputError bool
+	}{
+		{"36/200", api.RateLimit{Used: 36, Limit: 200}, false},
+		{" 200/200 ", api.RateLimit{Used: 200, Limit: 200}, false},
+		{"", api.RateLimit{}, true},
+		{"36", api.RateLimit{}, true},
+		{"a/200", api.RateLimit{}, true},
+		{"36/b", api.RateLimit{}, true},
+	}
+
+	for _, test := range table {
+		rl, err := api.ParseRateLimit(test.Input)
+		assert.Equal(t, test.OutputError, err != nil, test.Input)
+		assert.Equal(t, test.Output, rl, test.Input)
+	}
+}
+
+func TestRateLimit_Remaining(t *testing.T) {
+	assert.Equal(t, 164, api.RateLimit{Used: 36, Limit: 200}.Remaining())
+	assert.Equal(t, 0, api.RateLimit{Used: 200, Limit: 200}.Remaining())
+	assert.Equal(t, 0, api.RateLimit{Used: 201, Limit: 200}.Remaining())
+}
+
+func TestRateLimit_Exceeded(t *testing.T) {
+	assert.False(t, api.RateLimit{}.Exceeded())
+	assert.False(t, api.RateLimit{Used: 199, Limit: 200}.Exceeded())
+	assert.True(t, api.RateLimit{Used: 200, Limit: 200}.Exceeded())
+}
+
+func TestRateLimitError(t *testing.T) {
+	t.Run("with api error", func(t *testing.T) {
+		apiErr := &api.Error{ID: "429", Name: "too_many_requests", Detail: "Too many requests"}
+		err := &api.RateLimitError{
+			RateLimit:  api.RateLimit{Used: 200, Limit: 200},
+			RetryAfter: time.Minute,
+			Err:        apiErr,
+		}
+		assert.EqualError(t, err, "api: rate limit exceeded used=200 limit=200 retry_after=1m0s: "+
+			"api: error id=429 name=too_many_requests detail=Too many requests")
+
+		var target *api.Error
+		assert.True(t, errors.As(err, &target))
+		assert.Equal(t, apiErr, target)
+	})
+
+	t.Run("without api error", func(t *testing.T) {
+		err := &api.RateLimitError{RateLimit: api.RateLimit{Used: 200, Limit: 200}}
+		assert.EqualError(t, err, "api: rate limit exceeded used=200 limit=200 retry_after=0s")
+		assert.Nil(t, errors.Unwrap(err))
+	})
+}
@@ -0,0 +1,345 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package transaction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/brunomvsouza/ynab.go/api"
+)
+
+// DefaultBatchSize the maximum number of transactions updated per request
+// by the batch operations when BatchOptions.Size is not set
+const DefaultBatchSize = 100
+
+// ErrTransactionNotSaved is reported for the transactions of a batch
+// operation that were not saved by the API although the request succeeded
+var ErrTransactionNotSaved = errors.New("transaction: transaction not saved")
+
+// BatchOptions configures the batch operations updating transactions.
+// Deletes take no options as they are sent one request per transaction.
+type BatchOptions struct {
+	// Size the maximum number of transactions updated per request
+	Size int
+}
+
+// size returns the chunk size of the batch operations
+func (o *BatchOptions) size() int {
+	if o == nil || o.Size <= 0 {
+		return DefaultBatchSize
+	}
+	return o.Size
+}
+
+// BatchResult represents the outcome of a batch operation
+type BatchResult struct {
+	// Succeeded The IDs of the transactions the operation was applied to
+	Succeeded []string
+	// Failed The errors by ID of the transactions the operation failed for
+	Failed map[string]error
+}
+
+// succeed records ids as succeeded
+func (r *BatchResult) succeed(ids ...string) {
+	r.Succeeded = append(r.Succeeded, ids...)
+}
+
+// fail records ids as failed with err
+func (r *BatchResult) fail(err error, ids ...string) {
+	if r.Failed == nil {
+		r.Failed = make(map[string]error, len(ids))
+	}
+	for _, id := range ids {
+		r.Failed[id] = err
+	}
+}
+
+// err returns a *BatchError when the operation failed for any transaction
+func (r *BatchResult) err() error {
+	if len(r.Failed) == 0 {
+		return nil
+	}
+	return &BatchError{Failed: r.Failed}
+}
+
+// BatchError is returned by batch operations that failed for some of
+// the given transactions
+type BatchError struct {
+	// Failed The errors by ID of the transactions the operation failed for
+	Failed map[string]error
+}
+
+// Error returns the string version of the error
+func (e *BatchError) Error() string {
+	return fmt.Sprintf("transaction: batch operation failed for %d transaction(s)",
+		len(e.Failed))
+}
+
+// Unwrap returns the errors of the failed transactions
+func (e *BatchError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Failed))
+	for _, err := range e.Failed {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// SelectTransactionIDs returns the IDs of the transactions of a budget
+// matching the filter for which match returns true, to be used by the
+// batch operations
+// https://api.youneedabudget.com/v1#/Transactions/getTransactions
+func (s *Service) SelectTransactionIDs(budgetID string, f *Filter,
+	match func(*Transaction) bool) ([]string, error) {
+
+	return s.SelectTransactionIDsWithContext(context.Background(), budgetID, f, match)
+}
+
+// SelectTransactionIDsWithContext returns the IDs of the transactions of
+// a budget matching the filter for which match returns true, to be used by
+// the batch operations bound to ctx
+// https://api.youneedabudget.com/v1#/Transactions/getTransactions
+func (s *Service) SelectTransactionIDsWithContext(ctx context.Context, budgetID string,
+	f *Filter, match func(*Transaction) bool) ([]string, error) {
+
+	transactions, err := s.GetTransactionsWithContext(ctx, budgetID, f)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(transactions))
+	for _, tx := range transactions {
+		if tx.Deleted || !match(tx) {
+			continue
+		}
+		ids = append(ids, tx.ID)
+	}
+	return ids, nil
+}
+
+// ApproveTransactions approves the given transactions of a budget
+// https://api.youneedabudget.com/v1#/Transactions/updateTransactions
+func (s *Service) ApproveTransactions(budgetID string, transactionIDs []string,
+	opts *BatchOptions) (*BatchResult, error) {
+
+	return s.ApproveTransactionsWithContext(context.Background(), budgetID,
+		transactionIDs, opts)
+}
+
+// ApproveTransactionsWithContext approves the given transactions of a
+// budget bound to ctx
+// https://api.youneedabudget.com/v1#/Transactions/updateTransactions
+func (s *Service) ApproveTransactionsWithContext(ctx context.Context, budgetID string,
+	transactionIDs []string, opts *BatchOptions) (*BatchResult, error) {
+
+	patch := PayloadTransactionPatch{}
+	patch.SetApproved(true)
+	return s.patchTransactions(ctx, budgetID, transactionIDs, patch, opts)
+}
+
+// SetTransactionsCleared sets the clearing status of the given
+// transactions of a budget
+// https://api.youneedabudget.com/v1#/Transactions/updateTransactions
+func (s *Service) SetTransactionsCleared(budgetID string, transactionIDs []string,
+	status ClearingStatus, opts *BatchOptions) (*BatchResult, error) {
+
+	return s.SetTransactionsClearedWithContext(context.Background(), budgetID,
+		transactionIDs, status, opts)
+}
+
+// SetTransactionsClearedWithContext sets the clearing status of the given
+// transactions of a budget bound to ctx
+// https://api.youneedabudget.com/v1#/Transactions/updateTransactions
+func (s *Service) SetTransactionsClearedWithContext(ctx context.Context, budgetID string,
+	transactionIDs []string, status ClearingStatus, opts *BatchOptions) (*BatchResult, error) {
+
+	patch := PayloadTransactionPatch{}
+	patch.SetCleared(status)
+	return s.patchTransactions(ctx, budgetID, transactionIDs, patch, opts)
+}
+
+// SetTransactionsFlag sets the flag color of the given transactions of a
+// budget. A nil color removes their flag.
+// https://api.youneedabudget.com/v1#/Transactions/updateTransactions
+func (s *Service) SetTransactionsFlag(budgetID string, transactionIDs []string,
+	color *FlagColor, opts *BatchOptions) (*BatchResult, error) {
+
+	return s.SetTransactionsFlagWithContext(context.Background(), budgetID,
+		transactionIDs, color, opts)
+}
+
+// SetTransactionsFlagWithContext sets the flag color of the given
+// transactions of a budget bound to ctx. A nil color removes their flag.
+// https://api.youneedabudget.com/v1#/Transactions/updateTransactions
+func (s *Service) SetTransactionsFlagWithContext(ctx context.Context, budgetID string,
+	transactionIDs []string, color *FlagColor, opts *BatchOptions) (*BatchResult, error) {
+
+	patch := PayloadTransactionPatch{}
+	patch.SetFlagColor(color)
+	return s.patchTransactions(ctx, budgetID, transactionIDs, patch, opts)
+}
+
+// SetTransactionsCategory sets the category of the given transactions
+// of a budget
+// https://api.youneedabudget.com/v1#/Transactions/updateTransactions
+func (s *Service) SetTransactionsCategory(budgetID string, transactionIDs []string,
+	categoryID string, opts *BatchOptions) (*BatchResult, error) {
+
+	return s.SetTransactionsCategoryWithContext(context.Background(), budgetID,
+		transactionIDs, categoryID, opts)
+}
+
+// SetTransactionsCategoryWithContext sets the category of the given
+// transactions of a budget bound to ctx
+// https://api.youneedabudget.com/v1#/Transactions/updateTransactions
+func (s *Service) SetTransactionsCategoryWithContext(ctx context.Context, budgetID string,
+	transactionIDs []string, categoryID string, opts *BatchOptions) (*BatchResult, error) {
+
+	patch := PayloadTransactionPatch{}
+	patch.SetCategoryID(&categoryID)
+	return s.patchTransactions(ctx, budgetID, transactionIDs, patch, opts)
+}
+
+// DeleteTransactions deletes the given transactions of a budget, one
+// request per transaction. It takes no BatchOptions since the API has no
+// endpoint deleting multiple transactions at once to size requests for.
+// https://api.youneedabudget.com/v1#/Transactions/deleteTransaction
+func (s *Service) DeleteTransactions(budgetID string, transactionIDs []string) (*BatchResult, error) {
+	return s.DeleteTransactionsWithContext(context.Background(), budgetID, transactionIDs)
+}
+
+// DeleteTransactionsWithContext deletes the given transactions of a
+// budget, one request per transaction bound to ctx. It takes no
+// BatchOptions since the API has no endpoint deleting multiple
+// transactions at once to size requests for.
+// https://api.youneedabudget.com/v1#/Transactions/deleteTransaction
+func (s *Service) DeleteTransactionsWithContext(ctx context.Context, budgetID string,
+	transactionIDs []string) (*BatchResult, error) {
+
+	res := &BatchResult{}
+	for i, id := range transactionIDs {
+		if err := s.pace(ctx); err != nil {
+			res.fail(err, transactionIDs[i:]...)
+			break
+		}
+
+		if _, err := s.DeleteTransactionWithContext(ctx, budgetID, id); err != nil {
+			res.fail(err, id)
+			if isBatchFatal(err) {
+				res.fail(err, transactionIDs[i+1:]...)
+				break
+			}
+			continue
+		}
+		res.succeed(id)
+	}
+	return res, res.err()
+}
+
+// patchTransactions applies patch to the given transactions of a budget,
+// updating up to opts.Size transactions per request
+func (s *Service) patchTransactions(ctx context.Context, budgetID string,
+	transactionIDs []string, patch PayloadTransactionPatch,
+	opts *BatchOptions) (*BatchResult, error) {
+
+	chunks := chunkIDs(transactionIDs, opts.size())
+	res := &BatchResult{}
+	for i, chunk := range chunks {
+		if err := s.pace(ctx); err != nil {
+			for _, c := range chunks[i:] {
+				res.fail(err, c...)
+			}
+			break
+		}
+
+		patches := make([]PayloadTransactionPatch, 0, len(chunk))
+		for _, id := range chunk {
+			p := patch
+			p.ID = id
+			patches = append(patches, p)
+		}
+
+		// the patch sets absolute values, so repeating the request has
+		// the same effect
+		summary, err := s.PatchTransactionsWithContext(api.WithIdempotent(ctx),
+			budgetID, patches)
+		if err != nil {
+			res.fail(err, chunk...)
+			if isBatchFatal(err) {
+				for _, c := range chunks[i+1:] {
+					res.fail(err, c...)
+				}
+				break
+			}
+			continue
+		}
+
+		saved := make(map[string]bool, len(summary.TransactionIDs))
+		for _, id := range summary.TransactionIDs {
+			saved[id] = true
+		}
+		for _, id := range chunk {
+			if saved[id] {
+				res.succeed(id)
+			} else {
+				res.fail(ErrTransactionNotSaved, id)
+			}
+		}
+	}
+	return res, res.err()
+}
+
+// pace delays the next request of a batch operation once the request
+// quota of the current window, as reported by the client, is exhausted,
+// spreading the requests left evenly over the window. Requests are sent
+// right away while there is quota left.
+func (s *Service) pace(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r, ok := s.c.(api.RateLimitReporter)
+	if !ok {
+		return nil
+	}
+
+	used, limit := r.RateLimit()
+	if limit <= 0 || used < limit {
+		return nil
+	}
+
+	timer := time.NewTimer(api.RateLimitWindow / time.Duration(limit))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// isBatchFatal tells whether err prevents the remaining requests of a
+// batch operation from succeeding
+func isBatchFatal(err error) bool {
+	return errors.Is(err, api.ErrRateLimited) ||
+		errors.Is(err, context.Canceled) ||
+		errors.Is(err, context.DeadlineExceeded)
+}
+
+// chunkIDs splits ids into chunks of up to size IDs
+func chunkIDs(ids []string, size int) [][]string {
+	chunks := make([][]string, 0, (len(ids)+size-1)/size)
+	for size < len(ids) {
+		chunks = append(chunks, ids[:size:size])
+		ids = ids[size:]
+	}
+	if len(ids) > 0 {
+		chunks = append(chunks, ids)
+	}
+	return chunks
+}
@@ -0,0 +1,343 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package transaction_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+
+	"github.com/brunomvsouza/ynab.go"
+	"github.com/brunomvsouza/ynab.go/api"
+	"github.com/brunomvsouza/ynab.go/api/transaction"
+)
+
+const batchURL = "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/transactions"
+
+// batchResponder replies to batch updates as the API does, saving every
+// transaction of the request except the skipped ones
+func batchResponder(t *testing.T, bodies *[]string, skip ...string) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		buf, err := io.ReadAll(req.Body)
+		assert.NoError(t, err)
+		*bodies = append(*bodies, string(buf))
+
+		payload := struct {
+			Transactions []struct {
+				ID string `json:"id"`
+			} `json:"transactions"`
+		}{}
+		assert.NoError(t, json.Unmarshal(buf, &payload))
+
+		ids := make([]string, 0, len(payload.Transactions))
+	next:
+		for _, tx := range payload.Transactions {
+			for _, id := range skip {
+				if tx.ID == id {
+					continue next
+				}
+			}
+			ids = append(ids, tx.ID)
+		}
+
+		res, err := httpmock.NewJsonResponse(200, map[string]interface{}{
+			"data": map[string]interface{}{
+				"transaction_ids":  ids,
+				"server_knowledge": 10,
+			},
+		})
+		res.Header.Add("X-Rate-Limit", "36/200")
+		return res, err
+	}
+}
+
+func TestService_ApproveTransactions(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var bodies []string
+	httpmock.RegisterResponder(http.MethodPatch, batchURL, batchResponder(t, &bodies, "c"))
+
+	client := ynab.NewClient("")
+	res, err := client.Transaction().ApproveTransactions(
+		"aa248caa-eed7-4575-a990-717386438d2c",
+		[]string{"a", "b", "c"},
+		&transaction.BatchOptions{Size: 2},
+	)
+
+	assert.Equal(t, []string{
+		`{"transactions":[{"approved":true,"id":"a"},{"approved":true,"id":"b"}]}`,
+		`{"transactions":[{"approved":true,"id":"c"}]}`,
+	}, bodies)
+
+	assert.Equal(t, []string{"a", "b"}, res.Succeeded)
+	assert.Equal(t, map[string]error{"c": transaction.ErrTransactionNotSaved}, res.Failed)
+
+	var batchErr *transaction.BatchError
+	if assert.True(t, errors.As(err, &batchErr)) {
+		assert.Equal(t, res.Failed, batchErr.Failed)
+	}
+	assert.True(t, errors.Is(err, transaction.ErrTransactionNotSaved))
+}
+
+func TestService_SetTransactionsCleared(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var bodies []string
+	httpmock.RegisterResponder(http.MethodPatch, batchURL, batchResponder(t, &bodies))
+
+	client := ynab.NewClient("")
+	res, err := client.Transaction().SetTransactionsCleared(
+		"aa248caa-eed7-4575-a990-717386438d2c",
+		[]string{"a", "b"},
+		transaction.ClearingStatusCleared,
+		nil,
+	)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{
+		`{"transactions":[{"cleared":"cleared","id":"a"},{"cleared":"cleared","id":"b"}]}`,
+	}, bodies)
+	assert.Equal(t, []string{"a", "b"}, res.Succeeded)
+	assert.Empty(t, res.Failed)
+}
+
+func TestService_SetTransactionsFlag(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var bodies []string
+	httpmock.RegisterResponder(http.MethodPatch, batchURL, batchResponder(t, &bodies))
+
+	client := ynab.NewClient("")
+	color := transaction.FlagColorRed
+	_, err := client.Transaction().SetTransactionsFlag(
+		"aa248caa-eed7-4575-a990-717386438d2c", []string{"a"}, &color, nil)
+	assert.NoError(t, err)
+
+	_, err = client.Transaction().SetTransactionsFlag(
+		"aa248caa-eed7-4575-a990-717386438d2c", []string{"a"}, nil, nil)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{
+		`{"transactions":[{"flag_color":"red","id":"a"}]}`,
+		`{"transactions":[{"flag_color":null,"id":"a"}]}`,
+	}, bodies)
+}
+
+func TestService_SetTransactionsCategory(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var bodies []string
+	httpmock.RegisterResponder(http.MethodPatch, batchURL, batchResponder(t, &bodies))
+
+	client := ynab.NewClient("")
+	res, err := client.Transaction().SetTransactionsCategory(
+		"aa248caa-eed7-4575-a990-717386438d2c",
+		[]string{"a"},
+		"e9517027-6f16-4480-9515-5981bed2e9e1",
+		nil,
+	)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{
+		`{"transactions":[{"category_id":"e9517027-6f16-4480-9515-5981bed2e9e1","id":"a"}]}`,
+	}, bodies)
+	assert.Equal(t, []string{"a"}, res.Succeeded)
+}
+
+func TestService_ApproveTransactions_partialFailure(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var bodies []string
+	ok := batchResponder(t, &bodies)
+	httpmock.RegisterResponder(http.MethodPatch, batchURL,
+		func(req *http.Request) (*http.Response, error) {
+			if len(bodies) == 0 {
+				bodies = append(bodies, "")
+				return httpmock.NewStringResponse(400, `{
+  "error": {
+    "id": "400",
+    "name": "bad_request",
+    "detail": "Bad request"
+  }
+}`), nil
+			}
+			return ok(req)
+		},
+	)
+
+	client := ynab.NewClient("")
+	res, err := client.Transaction().ApproveTransactions(
+		"aa248caa-eed7-4575-a990-717386438d2c",
+		[]string{"a", "b", "c"},
+		&transaction.BatchOptions{Size: 2},
+	)
+
+	assert.Equal(t, []string{"c"}, res.Succeeded)
+	assert.Len(t, res.Failed, 2)
+	assert.True(t, errors.Is(res.Failed["a"], api.ErrBadRequest))
+	assert.True(t, errors.Is(res.Failed["b"], api.ErrBadRequest))
+	assert.True(t, errors.Is(err, api.ErrBadRequest))
+}
+
+func TestService_ApproveTransactions_rateLimited(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(http.MethodPatch, batchURL,
+		func(req *http.Request) (*http.Response, error) {
+			res := httpmock.NewStringResponse(429, `{
+  "error": {
+    "id": "429",
+    "name": "too_many_requests",
+    "detail": "Too many requests"
+  }
+}`)
+			res.Header.Add("X-Rate-Limit", "200/200")
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	res, err := client.Transaction().ApproveTransactions(
+		"aa248caa-eed7-4575-a990-717386438d2c",
+		[]string{"a", "b", "c"},
+		&transaction.BatchOptions{Size: 1},
+	)
+
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	assert.Empty(t, res.Succeeded)
+	assert.Len(t, res.Failed, 3)
+	for _, id := range []string{"a", "b", "c"} {
+		assert.True(t, errors.Is(res.Failed[id], api.ErrRateLimited))
+	}
+	assert.True(t, errors.Is(err, api.ErrRateLimited))
+}
+
+func TestService_ApproveTransactions_pacing(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var sent []time.Time
+	httpmock.RegisterResponder(http.MethodPatch, batchURL,
+		func(req *http.Request) (*http.Response, error) {
+			sent = append(sent, time.Now())
+			res := httpmock.NewStringResponse(200, `{"data": {"transaction_ids": ["a", "b"]}}`)
+			// no requests left in the window, the others are spread 10ms
+			// apart
+			res.Header.Add("X-Rate-Limit", "360000/360000")
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	res, err := client.Transaction().ApproveTransactions(
+		"aa248caa-eed7-4575-a990-717386438d2c",
+		[]string{"a", "b", "c"},
+		&transaction.BatchOptions{Size: 1},
+	)
+	if assert.Len(t, sent, 3) {
+		assert.True(t, sent[1].Sub(sent[0]) >= 10*time.Millisecond)
+		assert.True(t, sent[2].Sub(sent[1]) >= 10*time.Millisecond)
+	}
+	assert.Equal(t, []string{"a", "b"}, res.Succeeded)
+	assert.Equal(t, map[string]error{"c": transaction.ErrTransactionNotSaved}, res.Failed)
+	assert.Error(t, err)
+}
+
+func TestService_ApproveTransactions_pacingWithQuotaLeft(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(http.MethodPatch, batchURL,
+		func(req *http.Request) (*http.Response, error) {
+			res := httpmock.NewStringResponse(200, `{"data": {"transaction_ids": ["a", "b", "c"]}}`)
+			// fewer requests left in the window than in the batch, paced
+			// requests would wait half an hour each
+			res.Header.Add("X-Rate-Limit", "1/2")
+			return res, nil
+		},
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	client := ynab.NewClient("")
+	res, err := client.Transaction().ApproveTransactionsWithContext(ctx,
+		"aa248caa-eed7-4575-a990-717386438d2c",
+		[]string{"a", "b", "c"},
+		&transaction.BatchOptions{Size: 1},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, httpmock.GetTotalCallCount())
+	assert.Equal(t, []string{"a", "b", "c"}, res.Succeeded)
+}
+
+func TestService_DeleteTransactions(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	for _, id := range []string{"a", "c"} {
+		httpmock.RegisterResponder(http.MethodDelete, batchURL+"/"+id,
+			httpmock.NewStringResponder(200, `{"data": {"transaction": {"id": "`+id+`"}}}`))
+	}
+	httpmock.RegisterResponder(http.MethodDelete, batchURL+"/b",
+		httpmock.NewStringResponder(404, `{
+  "error": {
+    "id": "404.2",
+    "name": "resource_not_found",
+    "detail": "Resource not found"
+  }
+}`))
+
+	client := ynab.NewClient("")
+	res, err := client.Transaction().DeleteTransactions(
+		"aa248caa-eed7-4575-a990-717386438d2c",
+		[]string{"a", "b", "c"},
+	)
+
+	assert.Equal(t, 3, httpmock.GetTotalCallCount())
+	assert.Equal(t, []string{"a", "c"}, res.Succeeded)
+	assert.Len(t, res.Failed, 1)
+	assert.True(t, errors.Is(res.Failed["b"], api.ErrNotFound))
+	assert.True(t, errors.Is(err, api.ErrNotFound))
+}
+
+func TestService_SelectTransactionIDs(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(http.MethodGet, batchURL+"?type=unapproved",
+		httpmock.NewStringResponder(200, `{
+  "data": {
+    "transactions": [
+      {"id": "a", "amount": -1000, "deleted": false},
+      {"id": "b", "amount": 1000, "deleted": false},
+      {"id": "c", "amount": -1000, "deleted": true}
+    ]
+  }
+}`))
+
+	client := ynab.NewClient("")
+	status := transaction.StatusUnapproved
+	ids, err := client.Transaction().SelectTransactionIDs(
+		"aa248caa-eed7-4575-a990-717386438d2c",
+		&transaction.Filter{Type: &status},
+		func(tx *transaction.Transaction) bool { return tx.Amount < 0 },
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a"}, ids)
+}
@@ -37,6 +37,23 @@ type Transaction struct {
 	ImportID     *string `json:"import_id"`
 	PayeeName    *string `json:"payee_name"`
 	CategoryName *string `json:"category_name"`
+	// FlagName The customized name of the transaction flag
+	FlagName *string `json:"flag_name"`
+	// MatchedTransactionID If the transaction was matched with an imported
+	// transaction, the id of the matched transaction
+	MatchedTransactionID *string `json:"matched_transaction_id"`
+	// TransferTransactionID If a transfer, the id of the transaction on the
+	// other side of the transfer
+	TransferTransactionID *string `json:"transfer_transaction_id"`
+	// ImportPayeeName If the transaction was imported, the payee name that
+	// was used when importing and before applying any payee rename rules
+	ImportPayeeName *string `json:"import_payee_name"`
+	// ImportPayeeNameOriginal If the transaction was imported, the original
+	// payee name as it appeared on the statement
+	ImportPayeeNameOriginal *string `json:"import_payee_name_original"`
+	// DebtTransactionType If the transaction is a debt/loan account
+	// transaction, the type of transaction
+	DebtTransactionType *DebtTransactionType `json:"debt_transaction_type"`
 }
 
 // Summary represents the summary of a transaction for a budget
@@ -83,6 +100,11 @@ type SubTransaction struct {
 	// TransferAccountID If a transfer, the account_id which the
 	// sub-transaction transfers to
 	TransferAccountID *string `json:"transfer_account_id"`
+	// TransferTransactionID If a transfer, the id of the transaction on the
+	// other side of the transfer
+	TransferTransactionID *string `json:"transfer_transaction_id"`
+	PayeeName             *string `json:"payee_name"`
+	CategoryName          *string `json:"category_name"`
 }
 
 // Hybrid represents a hybrid transaction
@@ -118,6 +140,36 @@ type Hybrid struct {
 	ParentTransactionID *string `json:"parent_transaction_id"`
 	PayeeName           *string `json:"payee_name"`
 	CategoryName        *string `json:"category_name"`
+	// FlagName The customized name of the transaction flag
+	FlagName *string `json:"flag_name"`
+	// MatchedTransactionID If the transaction was matched with an imported
+	// transaction, the id of the matched transaction
+	MatchedTransactionID *string `json:"matched_transaction_id"`
+	// TransferTransactionID If a transfer, the id of the transaction on the
+	// other side of the transfer
+	TransferTransactionID *string `json:"transfer_transaction_id"`
+	// ImportPayeeName If the transaction was imported, the payee name that
+	// was used when importing and before applying any payee rename rules
+	ImportPayeeName *string `json:"import_payee_name"`
+	// ImportPayeeNameOriginal If the transaction was imported, the original
+	// payee name as it appeared on the statement
+	ImportPayeeNameOriginal *string `json:"import_payee_name_original"`
+	// DebtTransactionType If the transaction is a debt/loan account
+	// transaction, the type of transaction
+	DebtTransactionType *DebtTransactionType `json:"debt_transaction_type"`
+}
+
+// SearchResultSnapshot represents a versioned snapshot for a transaction search
+type SearchResultSnapshot struct {
+	Transactions    []*Transaction
+	ServerKnowledge uint64
+}
+
+// HybridSearchResultSnapshot represents a versioned snapshot for a
+// transaction search by category or payee
+type HybridSearchResultSnapshot struct {
+	Transactions    []*Hybrid
+	ServerKnowledge uint64
 }
 
 // Scheduled represents a scheduled transaction for a budget
@@ -145,6 +197,13 @@ type Scheduled struct {
 	CategoryName      *string `json:"category_name"`
 }
 
+// ScheduledSearchResultSnapshot represents a versioned snapshot for a
+// scheduled transaction search
+type ScheduledSearchResultSnapshot struct {
+	ScheduledTransactions []*Scheduled
+	ServerKnowledge       uint64
+}
+
 // ScheduledSummary represents the summary of a scheduled transaction for a budget
 type ScheduledSummary struct {
 	ID        string             `json:"id"`
@@ -208,3 +267,17 @@ type OperationSummary struct {
 	// Transactions If a single transaction was specified, the transaction that was saved
 	Transaction *Transaction `json:"transaction"`
 }
+
+// ImportSummary represents the output of transactions being imported from
+// linked accounts
+type ImportSummary struct {
+	// TransactionIDs The list of Transaction IDs that were imported
+	TransactionIDs []string `json:"transaction_ids"`
+	// Transactions The transactions that were imported, in the same order
+	// as TransactionIDs, except the ones in NotFoundTransactionIDs
+	Transactions []*Transaction `json:"transactions"`
+	// NotFoundTransactionIDs The IDs of the imported transactions no longer
+	// pending approval when fetched, e.g. approved in the meantime, which
+	// can be fetched with GetTransaction
+	NotFoundTransactionIDs []string `json:"not_found_transaction_ids"`
+}
@@ -49,6 +49,28 @@ const (
 	FlagColorPurple FlagColor = "purple"
 )
 
+// DebtTransactionType represents the type of a debt/loan account transaction
+type DebtTransactionType string
+
+const (
+	// DebtTransactionTypePayment identifies a payment towards the debt
+	DebtTransactionTypePayment DebtTransactionType = "payment"
+	// DebtTransactionTypeRefund identifies a refund
+	DebtTransactionTypeRefund DebtTransactionType = "refund"
+	// DebtTransactionTypeFee identifies a fee
+	DebtTransactionTypeFee DebtTransactionType = "fee"
+	// DebtTransactionTypeInterest identifies an interest charge
+	DebtTransactionTypeInterest DebtTransactionType = "interest"
+	// DebtTransactionTypeEscrow identifies an escrow payment
+	DebtTransactionTypeEscrow DebtTransactionType = "escrow"
+	// DebtTransactionTypeBalanceAdjustment identifies a balance adjustment
+	DebtTransactionTypeBalanceAdjustment DebtTransactionType = "balanceAdjustment"
+	// DebtTransactionTypeCredit identifies a credit
+	DebtTransactionTypeCredit DebtTransactionType = "credit"
+	// DebtTransactionTypeCharge identifies a charge
+	DebtTransactionTypeCharge DebtTransactionType = "charge"
+)
+
 // ScheduledFrequency represents the frequency of a scheduled transaction
 // or sub-transaction
 type ScheduledFrequency string
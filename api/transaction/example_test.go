@@ -5,8 +5,10 @@
 package transaction_test
 
 import (
+	"context"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/brunomvsouza/ynab.go"
 	"github.com/brunomvsouza/ynab.go/api"
@@ -95,6 +97,17 @@ func ExampleService_GetTransactions() {
 	// Output: []*transaction.Transaction
 }
 
+func ExampleService_GetTransactionsWithContext() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	c := ynab.NewClient("<valid_ynab_access_token>")
+	transactions, _ := c.Transaction().GetTransactionsWithContext(ctx, "<valid_budget_id>", nil)
+	fmt.Println(reflect.TypeOf(transactions))
+
+	// Output: []*transaction.Transaction
+}
+
 func ExampleService_GetTransactions_filtered() {
 	c := ynab.NewClient("<valid_ynab_access_token>")
 	date, _ := api.DateFromString("2010-09-09")
@@ -177,6 +190,25 @@ func ExampleService_GetTransactionsByPayee_filtered() {
 	// Output: []*transaction.Hybrid
 }
 
+func ExampleService_GetTransactionsByMonth() {
+	c := ynab.NewClient("<valid_ynab_access_token>")
+	month, _ := api.DateFromString("2010-09-01")
+	transactions, _ := c.Transaction().GetTransactionsByMonth(
+		"<valid_budget_id>", month, nil)
+	fmt.Println(reflect.TypeOf(transactions))
+
+	// Output: []*transaction.Transaction
+}
+
+func ExampleService_GetTransactionsByCurrentMonth() {
+	c := ynab.NewClient("<valid_ynab_access_token>")
+	transactions, _ := c.Transaction().GetTransactionsByCurrentMonth(
+		"<valid_budget_id>", nil)
+	fmt.Println(reflect.TypeOf(transactions))
+
+	// Output: []*transaction.Transaction
+}
+
 func ExampleService_GetScheduledTransaction() {
 	c := ynab.NewClient("<valid_ynab_access_token>")
 	tx, _ := c.Transaction().GetScheduledTransaction("<valid_budget_id>",
@@ -193,3 +225,113 @@ func ExampleService_GetScheduledTransactions() {
 
 	// Output: []*transaction.Scheduled
 }
+
+func ExampleService_CreateScheduledTransaction() {
+	c := ynab.NewClient("<valid_ynab_access_token>")
+	date, _ := api.DateFromString("2030-01-01")
+	p := transaction.PayloadScheduledTransaction{
+		AccountID: "<valid_account_id>",
+		DateFirst: date,
+		Frequency: transaction.FrequencyMonthly,
+		// ...
+	}
+	tx, _ := c.Transaction().CreateScheduledTransaction("<valid_budget_id>", p)
+	fmt.Println(reflect.TypeOf(tx))
+
+	// Output: *transaction.Scheduled
+}
+
+func ExampleService_UpdateScheduledTransaction() {
+	c := ynab.NewClient("<valid_ynab_access_token>")
+	p := transaction.PayloadScheduledTransaction{
+		AccountID: "<valid_account_id>",
+		// ...
+	}
+	tx, _ := c.Transaction().UpdateScheduledTransaction("<valid_budget_id>",
+		"<valid_scheduled_transaction_id>", p)
+	fmt.Println(reflect.TypeOf(tx))
+
+	// Output: *transaction.Scheduled
+}
+
+func ExampleService_DeleteScheduledTransaction() {
+	c := ynab.NewClient("<valid_ynab_access_token>")
+	tx, _ := c.Transaction().DeleteScheduledTransaction("<valid_budget_id>",
+		"<valid_scheduled_transaction_id>")
+	fmt.Println(reflect.TypeOf(tx))
+
+	// Output: *transaction.Scheduled
+}
+
+func ExampleService_ImportTransactions() {
+	c := ynab.NewClient("<valid_ynab_access_token>")
+	summary, _ := c.Transaction().ImportTransactions("<valid_budget_id>")
+	fmt.Println(reflect.TypeOf(summary))
+
+	// Output: *transaction.ImportSummary
+}
+
+func ExampleService_GetTransactionsSnapshot() {
+	c := ynab.NewClient("<valid_ynab_access_token>")
+	f := &transaction.Filter{LastKnowledgeOfServer: 10}
+	snapshot, _ := c.Transaction().GetTransactionsSnapshot("<valid_budget_id>", f)
+	fmt.Println(reflect.TypeOf(snapshot))
+
+	// Output: *transaction.SearchResultSnapshot
+}
+
+func ExampleService_GetScheduledTransactionsSnapshot() {
+	c := ynab.NewClient("<valid_ynab_access_token>")
+	f := &api.Filter{LastKnowledgeOfServer: 10}
+	snapshot, _ := c.Transaction().GetScheduledTransactionsSnapshot("<valid_budget_id>", f)
+	fmt.Println(reflect.TypeOf(snapshot))
+
+	// Output: *transaction.ScheduledSearchResultSnapshot
+}
+
+func ExampleService_ApproveTransactions() {
+	c := ynab.NewClient("<valid_ynab_access_token>")
+	status := transaction.StatusUnapproved
+	ids, _ := c.Transaction().SelectTransactionIDs("<valid_budget_id>",
+		&transaction.Filter{Type: &status},
+		func(tx *transaction.Transaction) bool { return tx.ImportID != nil })
+
+	res, _ := c.Transaction().ApproveTransactions("<valid_budget_id>", ids, nil)
+	fmt.Println(reflect.TypeOf(res))
+
+	// Output: *transaction.BatchResult
+}
+
+func ExampleService_DeleteTransactions() {
+	c := ynab.NewClient("<valid_ynab_access_token>")
+	res, _ := c.Transaction().DeleteTransactions("<valid_budget_id>",
+		[]string{"<valid_transaction_id>"})
+	fmt.Println(reflect.TypeOf(res))
+
+	// Output: *transaction.BatchResult
+}
+
+func ExampleService_PatchTransaction() {
+	c := ynab.NewClient("<valid_ynab_access_token>")
+	memo := "<new_memo>"
+	p := transaction.PayloadTransactionPatch{}
+	p.SetMemo(&memo).SetFlagColor(nil)
+
+	tx, _ := c.Transaction().PatchTransaction("<valid_budget_id>",
+		"<valid_transaction_id>", p)
+	fmt.Println(reflect.TypeOf(tx))
+
+	// Output: *transaction.Transaction
+}
+
+func ExampleService_PatchTransactions() {
+	c := ynab.NewClient("<valid_ynab_access_token>")
+	p := transaction.PayloadTransactionPatch{ID: "<valid_transaction_id>"}
+	p.SetApproved(true)
+
+	summary, _ := c.Transaction().PatchTransactions("<valid_budget_id>",
+		[]transaction.PayloadTransactionPatch{p})
+	fmt.Println(reflect.TypeOf(summary))
+
+	// Output: *transaction.OperationSummary
+}
@@ -5,9 +5,21 @@
 package transaction
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
 	"github.com/brunomvsouza/ynab.go/api"
 )
 
+// ErrSubTransactionsAmountMismatch is returned before sending a payload
+// whose sub-transaction amounts do not sum up to the transaction amount
+var ErrSubTransactionsAmountMismatch = errors.New("transaction: sub-transaction amounts do not sum up to the transaction amount")
+
+// ErrPatchWithoutID is returned before sending multiple patches when any
+// of them has no ID set
+var ErrPatchWithoutID = errors.New("transaction: patch without ID")
+
 // PayloadTransaction is the payload contract for saving a transaction, new or existent
 type PayloadTransaction struct {
 	ID        string   `json:"id"`
@@ -30,6 +42,8 @@ type PayloadTransaction struct {
 	CategoryID *string    `json:"category_id"`
 	Memo       *string    `json:"memo"`
 	FlagColor  *FlagColor `json:"flag_color"`
+	// FlagName The customized name of the transaction flag
+	FlagName *string `json:"flag_name"`
 	// ImportID If the Transaction was imported, this field is a unique (by account) import
 	// identifier. If this transaction was imported through File Based Import or
 	// Direct Import and not through the API, the import_id will have the format:
@@ -39,4 +53,207 @@ type PayloadTransaction struct {
 	// was imported and had the same date and same amount, its import_id would
 	// be 'YNAB:-294230:2015-12-30:2’.
 	ImportID *string `json:"import_id"`
+	// SubTransactions The splits of the transaction. Their amounts must sum
+	// up to Amount. Sub-transactions of an existing split transaction
+	// cannot be updated.
+	SubTransactions []PayloadSubTransaction `json:"subtransactions,omitempty"`
+}
+
+// PayloadTransactionPatch is the payload contract for partially updating
+// an existing transaction. Only the fields set through its setters are
+// sent, leaving the others untouched. Setters of nullable fields clear
+// the field when given nil.
+type PayloadTransactionPatch struct {
+	// ID The ID of the transaction, required when updating multiple
+	// transactions at once
+	ID string
+
+	fields map[string]interface{}
+}
+
+// set sets the value of a field of the patch. The fields are copied
+// before being written so copies of the patch, e.g. made from a template,
+// never share them.
+func (p *PayloadTransactionPatch) set(field string, value interface{}) *PayloadTransactionPatch {
+	fields := make(map[string]interface{}, len(p.fields)+1)
+	for f, v := range p.fields {
+		fields[f] = v
+	}
+	fields[field] = value
+	p.fields = fields
+	return p
+}
+
+// SetAccountID sets the account of the transaction
+func (p *PayloadTransactionPatch) SetAccountID(accountID string) *PayloadTransactionPatch {
+	return p.set("account_id", accountID)
+}
+
+// SetDate sets the date of the transaction
+func (p *PayloadTransactionPatch) SetDate(date api.Date) *PayloadTransactionPatch {
+	return p.set("date", date)
+}
+
+// SetAmount sets the amount of the transaction in milliunits format
+func (p *PayloadTransactionPatch) SetAmount(amount int64) *PayloadTransactionPatch {
+	return p.set("amount", amount)
+}
+
+// SetCleared sets the clearing status of the transaction
+func (p *PayloadTransactionPatch) SetCleared(cleared ClearingStatus) *PayloadTransactionPatch {
+	return p.set("cleared", cleared)
+}
+
+// SetApproved sets whether the transaction is approved
+func (p *PayloadTransactionPatch) SetApproved(approved bool) *PayloadTransactionPatch {
+	return p.set("approved", approved)
+}
+
+// SetPayeeID sets the payee of the transaction. Transfer payees are not
+// permitted and will be ignored if supplied.
+func (p *PayloadTransactionPatch) SetPayeeID(payeeID *string) *PayloadTransactionPatch {
+	return p.set("payee_id", payeeID)
+}
+
+// SetPayeeName sets the payee name of the transaction, used to resolve
+// the payee when the payee ID is null
+func (p *PayloadTransactionPatch) SetPayeeName(payeeName *string) *PayloadTransactionPatch {
+	return p.set("payee_name", payeeName)
+}
+
+// SetCategoryID sets the category of the transaction. Split and Credit
+// Card Payment categories are not permitted and will be ignored if supplied.
+func (p *PayloadTransactionPatch) SetCategoryID(categoryID *string) *PayloadTransactionPatch {
+	return p.set("category_id", categoryID)
+}
+
+// SetMemo sets the memo of the transaction
+func (p *PayloadTransactionPatch) SetMemo(memo *string) *PayloadTransactionPatch {
+	return p.set("memo", memo)
+}
+
+// SetFlagColor sets the flag color of the transaction
+func (p *PayloadTransactionPatch) SetFlagColor(flagColor *FlagColor) *PayloadTransactionPatch {
+	return p.set("flag_color", flagColor)
+}
+
+// SetFlagName sets the customized name of the transaction flag
+func (p *PayloadTransactionPatch) SetFlagName(flagName *string) *PayloadTransactionPatch {
+	return p.set("flag_name", flagName)
+}
+
+// MarshalJSON encodes the ID and the fields set on the patch
+func (p PayloadTransactionPatch) MarshalJSON() ([]byte, error) {
+	fields := make(map[string]interface{}, len(p.fields)+1)
+	for field, value := range p.fields {
+		fields[field] = value
+	}
+	if p.ID != "" {
+		fields["id"] = p.ID
+	}
+	return json.Marshal(fields)
+}
+
+// PayloadSubTransaction is the payload contract for a split of a transaction
+type PayloadSubTransaction struct {
+	// Amount The sub-transaction amount in milliunits format
+	Amount int64 `json:"amount"`
+	// PayeeID Transfer payees are not permitted and will be ignored if supplied
+	PayeeID   *string `json:"payee_id"`
+	PayeeName *string `json:"payee_name"`
+	// CategoryID Credit Card Payment categories are not permitted and will
+	// be ignored if supplied
+	CategoryID *string `json:"category_id"`
+	Memo       *string `json:"memo"`
+}
+
+// validate checks the sub-transaction amounts sum up to the transaction amount
+func (p PayloadTransaction) validate() error {
+	if len(p.SubTransactions) == 0 {
+		return nil
+	}
+
+	var sum int64
+	for _, sub := range p.SubTransactions {
+		sum += sub.Amount
+	}
+	if sum != p.Amount {
+		return ErrSubTransactionsAmountMismatch
+	}
+	return nil
+}
+
+// validate checks the sub-transaction amounts sum up to the scheduled
+// transaction amount
+func (p PayloadScheduledTransaction) validate() error {
+	if len(p.SubTransactions) == 0 {
+		return nil
+	}
+
+	var sum int64
+	for _, sub := range p.SubTransactions {
+		sum += sub.Amount
+	}
+	if sum != p.Amount {
+		return ErrSubTransactionsAmountMismatch
+	}
+	return nil
+}
+
+// validatePayloads validates every payload of ps
+func validatePayloads(ps []PayloadTransaction) error {
+	for _, p := range ps {
+		if err := p.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validatePatches checks every patch of ps has an ID, as required when
+// updating multiple transactions at once
+func validatePatches(ps []PayloadTransactionPatch) error {
+	for i, p := range ps {
+		if p.ID == "" {
+			return fmt.Errorf("%w: index %d", ErrPatchWithoutID, i)
+		}
+	}
+	return nil
+}
+
+// PayloadScheduledTransaction is the payload contract for saving a
+// scheduled transaction, new or existent
+type PayloadScheduledTransaction struct {
+	AccountID string `json:"account_id"`
+	// DateFirst The first date of the scheduled transaction. It must be a
+	// future date, no more than 5 years into the future.
+	DateFirst api.Date           `json:"date"`
+	Frequency ScheduledFrequency `json:"frequency"`
+	// Amount The scheduled transaction amount in milliunits format
+	Amount int64 `json:"amount"`
+
+	// PayeeID Transfer payees are permitted and will create a scheduled transfer
+	PayeeID *string `json:"payee_id"`
+	// PayeeName If the payee name is provided and payee ID has a null value, the
+	// payee name value will be used to resolve the payee by either (1) a payee
+	// with the same name or (2) creation of a new payee
+	PayeeName *string `json:"payee_name"`
+	// CategoryID Credit Card Payment categories are not permitted
+	CategoryID *string    `json:"category_id"`
+	Memo       *string    `json:"memo"`
+	FlagColor  *FlagColor `json:"flag_color"`
+	// SubTransactions The splits of the scheduled transaction. Their amounts
+	// must sum up to Amount.
+	SubTransactions []PayloadScheduledSubTransaction `json:"subtransactions,omitempty"`
+}
+
+// PayloadScheduledSubTransaction is the payload contract for a split of
+// a scheduled transaction
+type PayloadScheduledSubTransaction struct {
+	// Amount The scheduled sub-transaction amount in milliunits format
+	Amount     int64   `json:"amount"`
+	PayeeID    *string `json:"payee_id"`
+	PayeeName  *string `json:"payee_name"`
+	CategoryID *string `json:"category_id"`
+	Memo       *string `json:"memo"`
 }
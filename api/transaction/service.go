@@ -5,6 +5,7 @@
 package transaction
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -12,6 +13,8 @@ import (
 	"github.com/brunomvsouza/ynab.go/api"
 )
 
+const currentMonthID = "current"
+
 // NewService facilitates the creation of a new transaction service instance
 func NewService(c api.ClientReaderWriter) *Service {
 	return &Service{c}
@@ -26,9 +29,41 @@ type Service struct {
 // a budget with filtering capabilities
 // https://api.youneedabudget.com/v1#/Transactions/getTransactions
 func (s *Service) GetTransactions(budgetID string, f *Filter) ([]*Transaction, error) {
+	return s.GetTransactionsWithContext(context.Background(), budgetID, f)
+}
+
+// GetTransactionsWithContext fetches the list of transactions from
+// a budget with filtering capabilities bound to ctx
+// https://api.youneedabudget.com/v1#/Transactions/getTransactions
+func (s *Service) GetTransactionsWithContext(ctx context.Context, budgetID string,
+	f *Filter) ([]*Transaction, error) {
+
+	snapshot, err := s.GetTransactionsSnapshotWithContext(ctx, budgetID, f)
+	if err != nil {
+		return nil, err
+	}
+	return snapshot.Transactions, nil
+}
+
+// GetTransactionsSnapshot fetches the list of transactions from a budget
+// with filtering capabilities, along with the server knowledge to be used
+// in the next delta request
+// https://api.youneedabudget.com/v1#/Transactions/getTransactions
+func (s *Service) GetTransactionsSnapshot(budgetID string, f *Filter) (*SearchResultSnapshot, error) {
+	return s.GetTransactionsSnapshotWithContext(context.Background(), budgetID, f)
+}
+
+// GetTransactionsSnapshotWithContext fetches the list of transactions from
+// a budget with filtering capabilities, along with the server knowledge to
+// be used in the next delta request bound to ctx
+// https://api.youneedabudget.com/v1#/Transactions/getTransactions
+func (s *Service) GetTransactionsSnapshotWithContext(ctx context.Context, budgetID string,
+	f *Filter) (*SearchResultSnapshot, error) {
+
 	resModel := struct {
 		Data struct {
-			Transactions []*Transaction `json:"transactions"`
+			Transactions    []*Transaction `json:"transactions"`
+			ServerKnowledge uint64         `json:"server_knowledge"`
 		} `json:"data"`
 	}{}
 
@@ -37,16 +72,27 @@ func (s *Service) GetTransactions(budgetID string, f *Filter) ([]*Transaction, e
 		url = fmt.Sprintf("%s?%s", url, f.ToQuery())
 	}
 
-	if err := s.c.GET(url, &resModel); err != nil {
+	if err := s.c.GETWithContext(ctx, url, &resModel); err != nil {
 		return nil, err
 	}
-
-	return resModel.Data.Transactions, nil
+	return &SearchResultSnapshot{
+		Transactions:    resModel.Data.Transactions,
+		ServerKnowledge: resModel.Data.ServerKnowledge,
+	}, nil
 }
 
 // GetTransaction fetches a specific transaction from a budget
 // https://api.youneedabudget.com/v1#/Transactions/getTransactionsById
 func (s *Service) GetTransaction(budgetID, transactionID string) (*Transaction, error) {
+	return s.GetTransactionWithContext(context.Background(), budgetID, transactionID)
+}
+
+// GetTransactionWithContext fetches a specific transaction from a budget
+// bound to ctx
+// https://api.youneedabudget.com/v1#/Transactions/getTransactionsById
+func (s *Service) GetTransactionWithContext(ctx context.Context, budgetID,
+	transactionID string) (*Transaction, error) {
+
 	resModel := struct {
 		Data struct {
 			Transaction *Transaction `json:"transaction"`
@@ -54,7 +100,7 @@ func (s *Service) GetTransaction(budgetID, transactionID string) (*Transaction,
 	}{}
 
 	url := fmt.Sprintf("/budgets/%s/transactions/%s", budgetID, transactionID)
-	if err := s.c.GET(url, &resModel); err != nil {
+	if err := s.c.GETWithContext(ctx, url, &resModel); err != nil {
 		return nil, err
 	}
 	return resModel.Data.Transaction, nil
@@ -68,11 +114,38 @@ func (s *Service) CreateTransaction(budgetID string,
 	return s.CreateTransactions(budgetID, []PayloadTransaction{p})
 }
 
+// CreateTransactionWithContext creates a new transaction for a budget
+// bound to ctx
+// https://api.youneedabudget.com/v1#/Transactions/createTransaction
+func (s *Service) CreateTransactionWithContext(ctx context.Context, budgetID string,
+	p PayloadTransaction) (*OperationSummary, error) {
+
+	return s.CreateTransactionsWithContext(ctx, budgetID, []PayloadTransaction{p})
+}
+
 // CreateTransactions creates one or more new transactions for a budget
 // https://api.youneedabudget.com/v1#/Transactions/createTransaction
 func (s *Service) CreateTransactions(budgetID string,
 	p []PayloadTransaction) (*OperationSummary, error) {
 
+	return s.CreateTransactionsWithContext(context.Background(), budgetID, p)
+}
+
+// CreateTransactionsWithContext creates one or more new transactions for
+// a budget bound to ctx. The request is marked as idempotent when every
+// transaction carries an ImportID, as YNAB refuses duplicated imports.
+// https://api.youneedabudget.com/v1#/Transactions/createTransaction
+func (s *Service) CreateTransactionsWithContext(ctx context.Context, budgetID string,
+	p []PayloadTransaction) (*OperationSummary, error) {
+
+	if err := validatePayloads(p); err != nil {
+		return nil, err
+	}
+
+	if hasImportIDs(p) {
+		ctx = api.WithIdempotent(ctx)
+	}
+
 	payload := struct {
 		Transactions []PayloadTransaction `json:"transactions"`
 	}{
@@ -89,7 +162,7 @@ func (s *Service) CreateTransactions(budgetID string,
 	}{}
 
 	url := fmt.Sprintf("/budgets/%s/transactions", budgetID)
-	err = s.c.POST(url, &resModel, buf)
+	err = s.c.POSTWithContext(ctx, url, &resModel, buf)
 	if err != nil {
 		return nil, err
 	}
@@ -102,6 +175,20 @@ func (s *Service) CreateTransactions(budgetID string,
 func (s *Service) BulkCreateTransactions(budgetID string,
 	ps []PayloadTransaction) (*Bulk, error) {
 
+	return s.BulkCreateTransactionsWithContext(context.Background(), budgetID, ps)
+}
+
+// BulkCreateTransactionsWithContext creates multiple transactions for a
+// budget bound to ctx
+// https://api.youneedabudget.com/v1#/Transactions/bulkCreateTransactions
+// Deprecated: Use transaction.CreateTransactionsWithContext instead.
+func (s *Service) BulkCreateTransactionsWithContext(ctx context.Context, budgetID string,
+	ps []PayloadTransaction) (*Bulk, error) {
+
+	if err := validatePayloads(ps); err != nil {
+		return nil, err
+	}
+
 	payload := struct {
 		Transactions []PayloadTransaction `json:"transactions"`
 	}{
@@ -120,9 +207,10 @@ func (s *Service) BulkCreateTransactions(budgetID string,
 	}{}
 
 	url := fmt.Sprintf("/budgets/%s/transactions/bulk", budgetID)
-	if err := s.c.POST(url, &resModel, buf); err != nil {
+	if err := s.c.POSTWithContext(ctx, url, &resModel, buf); err != nil {
 		return nil, err
 	}
+
 	return resModel.Data.Bulk, nil
 }
 
@@ -131,6 +219,19 @@ func (s *Service) BulkCreateTransactions(budgetID string,
 func (s *Service) UpdateTransaction(budgetID, transactionID string,
 	p PayloadTransaction) (*Transaction, error) {
 
+	return s.UpdateTransactionWithContext(context.Background(), budgetID, transactionID, p)
+}
+
+// UpdateTransactionWithContext updates a whole transaction for a replacement
+// bound to ctx
+// https://api.youneedabudget.com/v1#/Transactions/updateTransaction
+func (s *Service) UpdateTransactionWithContext(ctx context.Context, budgetID,
+	transactionID string, p PayloadTransaction) (*Transaction, error) {
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
 	payload := struct {
 		Transaction *PayloadTransaction `json:"transaction"`
 	}{
@@ -149,7 +250,7 @@ func (s *Service) UpdateTransaction(budgetID, transactionID string,
 	}{}
 
 	url := fmt.Sprintf("/budgets/%s/transactions/%s", budgetID, transactionID)
-	if err := s.c.PUT(url, &resModel, buf); err != nil {
+	if err := s.c.PUTWithContext(ctx, url, &resModel, buf); err != nil {
 		return nil, err
 	}
 	return resModel.Data.Transaction, nil
@@ -160,6 +261,19 @@ func (s *Service) UpdateTransaction(budgetID, transactionID string,
 func (s *Service) UpdateTransactions(budgetID string,
 	p []PayloadTransaction) (*OperationSummary, error) {
 
+	return s.UpdateTransactionsWithContext(context.Background(), budgetID, p)
+}
+
+// UpdateTransactionsWithContext creates one or more new transactions for
+// a budget bound to ctx
+// https://api.youneedabudget.com/v1#/Transactions/updateTransactions
+func (s *Service) UpdateTransactionsWithContext(ctx context.Context, budgetID string,
+	p []PayloadTransaction) (*OperationSummary, error) {
+
+	if err := validatePayloads(p); err != nil {
+		return nil, err
+	}
+
 	payload := struct {
 		Transactions []PayloadTransaction `json:"transactions"`
 	}{
@@ -176,7 +290,90 @@ func (s *Service) UpdateTransactions(budgetID string,
 	}{}
 
 	url := fmt.Sprintf("/budgets/%s/transactions", budgetID)
-	err = s.c.PATCH(url, &resModel, buf)
+	err = s.c.PATCHWithContext(ctx, url, &resModel, buf)
+	if err != nil {
+		return nil, err
+	}
+	return resModel.Data, nil
+}
+
+// PatchTransaction updates only the fields set on the patch of a transaction
+// https://api.youneedabudget.com/v1#/Transactions/updateTransaction
+func (s *Service) PatchTransaction(budgetID, transactionID string,
+	p PayloadTransactionPatch) (*Transaction, error) {
+
+	return s.PatchTransactionWithContext(context.Background(), budgetID, transactionID, p)
+}
+
+// PatchTransactionWithContext updates only the fields set on the patch of
+// a transaction bound to ctx
+// https://api.youneedabudget.com/v1#/Transactions/updateTransaction
+func (s *Service) PatchTransactionWithContext(ctx context.Context, budgetID,
+	transactionID string, p PayloadTransactionPatch) (*Transaction, error) {
+
+	// the transaction is identified by the URL
+	p.ID = ""
+
+	payload := struct {
+		Transaction *PayloadTransactionPatch `json:"transaction"`
+	}{
+		&p,
+	}
+
+	buf, err := json.Marshal(&payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resModel := struct {
+		Data struct {
+			Transaction *Transaction `json:"transaction"`
+		} `json:"data"`
+	}{}
+
+	url := fmt.Sprintf("/budgets/%s/transactions/%s", budgetID, transactionID)
+	if err := s.c.PUTWithContext(ctx, url, &resModel, buf); err != nil {
+		return nil, err
+	}
+	return resModel.Data.Transaction, nil
+}
+
+// PatchTransactions updates only the fields set on the patches of
+// multiple transactions, identified by their IDs
+// https://api.youneedabudget.com/v1#/Transactions/updateTransactions
+func (s *Service) PatchTransactions(budgetID string,
+	p []PayloadTransactionPatch) (*OperationSummary, error) {
+
+	return s.PatchTransactionsWithContext(context.Background(), budgetID, p)
+}
+
+// PatchTransactionsWithContext updates only the fields set on the patches
+// of multiple transactions, identified by their IDs bound to ctx
+// https://api.youneedabudget.com/v1#/Transactions/updateTransactions
+func (s *Service) PatchTransactionsWithContext(ctx context.Context, budgetID string,
+	p []PayloadTransactionPatch) (*OperationSummary, error) {
+
+	if err := validatePatches(p); err != nil {
+		return nil, err
+	}
+
+	payload := struct {
+		Transactions []PayloadTransactionPatch `json:"transactions"`
+	}{
+		p,
+	}
+
+	buf, err := json.Marshal(&payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resModel := struct {
+		Data *OperationSummary `json:"data"`
+	}{}
+
+	url := fmt.Sprintf("/budgets/%s/transactions", budgetID)
+	err = s.c.PATCHWithContext(ctx, url, &resModel, buf)
 	if err != nil {
 		return nil, err
 	}
@@ -186,6 +383,14 @@ func (s *Service) UpdateTransactions(budgetID string,
 // DeleteTransaction deletes a transaction from a budget
 // https://api.youneedabudget.com/v1#/Transactions/deleteTransaction
 func (s *Service) DeleteTransaction(budgetID, transactionID string) (*Transaction, error) {
+	return s.DeleteTransactionWithContext(context.Background(), budgetID, transactionID)
+}
+
+// DeleteTransactionWithContext deletes a transaction from a budget bound to ctx
+// https://api.youneedabudget.com/v1#/Transactions/deleteTransaction
+func (s *Service) DeleteTransactionWithContext(ctx context.Context, budgetID,
+	transactionID string) (*Transaction, error) {
+
 	resModel := struct {
 		Data struct {
 			Transaction *Transaction `json:"transaction"`
@@ -193,22 +398,120 @@ func (s *Service) DeleteTransaction(budgetID, transactionID string) (*Transactio
 	}{}
 
 	url := fmt.Sprintf("/budgets/%s/transactions/%s", budgetID, transactionID)
-	err := s.c.DELETE(url, &resModel)
+	err := s.c.DELETEWithContext(ctx, url, &resModel)
 	if err != nil {
 		return nil, err
 	}
 	return resModel.Data.Transaction, nil
 }
 
+// ImportTransactions imports available transactions on all linked accounts
+// of a budget, returning the IDs of the imported transactions along with
+// the transactions themselves. The IDs are returned even when fetching
+// the transactions fails, as the API will not return them again.
+// https://api.youneedabudget.com/v1#/Transactions/importTransactions
+func (s *Service) ImportTransactions(budgetID string) (*ImportSummary, error) {
+	return s.ImportTransactionsWithContext(context.Background(), budgetID)
+}
+
+// ImportTransactionsWithContext imports available transactions on all
+// linked accounts of a budget bound to ctx, returning the IDs of the
+// imported transactions along with the transactions themselves. The IDs
+// are returned even when fetching the transactions fails, as the API will
+// not return them again.
+// https://api.youneedabudget.com/v1#/Transactions/importTransactions
+func (s *Service) ImportTransactionsWithContext(ctx context.Context,
+	budgetID string) (*ImportSummary, error) {
+
+	resModel := struct {
+		Data struct {
+			TransactionIDs []string `json:"transaction_ids"`
+		} `json:"data"`
+	}{}
+
+	url := fmt.Sprintf("/budgets/%s/transactions/import", budgetID)
+	if err := s.c.POSTWithContext(ctx, url, &resModel, nil); err != nil {
+		return nil, err
+	}
+
+	summary := &ImportSummary{
+		TransactionIDs: resModel.Data.TransactionIDs,
+		Transactions:   []*Transaction{},
+	}
+	if len(summary.TransactionIDs) == 0 {
+		return summary, nil
+	}
+
+	// imported transactions are pending approval, so fetching the unapproved
+	// ones brings them all in a single request. Their dates are unknown
+	// until then, so the request cannot be narrowed down with Since.
+	transactions, err := s.GetTransactionsWithContext(ctx, budgetID, &Filter{
+		Type: StatusUnapproved.Pointer(),
+	})
+	if err != nil {
+		return summary, err
+	}
+
+	byID := make(map[string]*Transaction, len(transactions))
+	for _, tx := range transactions {
+		byID[tx.ID] = tx
+	}
+	for _, id := range summary.TransactionIDs {
+		tx, ok := byID[id]
+		if !ok {
+			summary.NotFoundTransactionIDs = append(summary.NotFoundTransactionIDs, id)
+			continue
+		}
+		summary.Transactions = append(summary.Transactions, tx)
+	}
+	return summary, nil
+}
+
 // GetTransactionsByAccount fetches the list of transactions of a specific account
 // from a budget with filtering capabilities
 // https://api.youneedabudget.com/v1#/Transactions/getTransactionsByAccount
 func (s *Service) GetTransactionsByAccount(budgetID, accountID string,
 	f *Filter) ([]*Transaction, error) {
 
+	return s.GetTransactionsByAccountWithContext(context.Background(), budgetID, accountID, f)
+}
+
+// GetTransactionsByAccountWithContext fetches the list of transactions of
+// a specific account from a budget with filtering capabilities bound to ctx
+// https://api.youneedabudget.com/v1#/Transactions/getTransactionsByAccount
+func (s *Service) GetTransactionsByAccountWithContext(ctx context.Context, budgetID,
+	accountID string, f *Filter) ([]*Transaction, error) {
+
+	snapshot, err := s.GetTransactionsByAccountSnapshotWithContext(ctx, budgetID, accountID, f)
+	if err != nil {
+		return nil, err
+	}
+	return snapshot.Transactions, nil
+}
+
+// GetTransactionsByAccountSnapshot fetches the list of transactions of a
+// specific account from a budget with filtering capabilities, along with
+// the server knowledge to be used in the next delta request
+// https://api.youneedabudget.com/v1#/Transactions/getTransactionsByAccount
+func (s *Service) GetTransactionsByAccountSnapshot(budgetID, accountID string,
+	f *Filter) (*SearchResultSnapshot, error) {
+
+	return s.GetTransactionsByAccountSnapshotWithContext(context.Background(),
+		budgetID, accountID, f)
+}
+
+// GetTransactionsByAccountSnapshotWithContext fetches the list of
+// transactions of a specific account from a budget with filtering
+// capabilities, along with the server knowledge to be used in the next
+// delta request bound to ctx
+// https://api.youneedabudget.com/v1#/Transactions/getTransactionsByAccount
+func (s *Service) GetTransactionsByAccountSnapshotWithContext(ctx context.Context, budgetID,
+	accountID string, f *Filter) (*SearchResultSnapshot, error) {
+
 	resModel := struct {
 		Data struct {
-			Transactions []*Transaction `json:"transactions"`
+			Transactions    []*Transaction `json:"transactions"`
+			ServerKnowledge uint64         `json:"server_knowledge"`
 		} `json:"data"`
 	}{}
 
@@ -217,11 +520,13 @@ func (s *Service) GetTransactionsByAccount(budgetID, accountID string,
 		url = fmt.Sprintf("%s?%s", url, f.ToQuery())
 	}
 
-	if err := s.c.GET(url, &resModel); err != nil {
+	if err := s.c.GETWithContext(ctx, url, &resModel); err != nil {
 		return nil, err
 	}
-
-	return resModel.Data.Transactions, nil
+	return &SearchResultSnapshot{
+		Transactions:    resModel.Data.Transactions,
+		ServerKnowledge: resModel.Data.ServerKnowledge,
+	}, nil
 }
 
 // GetTransactionsByCategory fetches the list of transactions of a specific category
@@ -230,9 +535,45 @@ func (s *Service) GetTransactionsByAccount(budgetID, accountID string,
 func (s *Service) GetTransactionsByCategory(budgetID, categoryID string,
 	f *Filter) ([]*Hybrid, error) {
 
+	return s.GetTransactionsByCategoryWithContext(context.Background(), budgetID, categoryID, f)
+}
+
+// GetTransactionsByCategoryWithContext fetches the list of transactions of
+// a specific category from a budget with filtering capabilities bound to ctx
+// https://api.youneedabudget.com/v1#/Transactions/getTransactionsByCategory
+func (s *Service) GetTransactionsByCategoryWithContext(ctx context.Context, budgetID,
+	categoryID string, f *Filter) ([]*Hybrid, error) {
+
+	snapshot, err := s.GetTransactionsByCategorySnapshotWithContext(ctx, budgetID, categoryID, f)
+	if err != nil {
+		return nil, err
+	}
+	return snapshot.Transactions, nil
+}
+
+// GetTransactionsByCategorySnapshot fetches the list of transactions of a
+// specific category from a budget with filtering capabilities, along with
+// the server knowledge to be used in the next delta request
+// https://api.youneedabudget.com/v1#/Transactions/getTransactionsByCategory
+func (s *Service) GetTransactionsByCategorySnapshot(budgetID, categoryID string,
+	f *Filter) (*HybridSearchResultSnapshot, error) {
+
+	return s.GetTransactionsByCategorySnapshotWithContext(context.Background(),
+		budgetID, categoryID, f)
+}
+
+// GetTransactionsByCategorySnapshotWithContext fetches the list of
+// transactions of a specific category from a budget with filtering
+// capabilities, along with the server knowledge to be used in the next
+// delta request bound to ctx
+// https://api.youneedabudget.com/v1#/Transactions/getTransactionsByCategory
+func (s *Service) GetTransactionsByCategorySnapshotWithContext(ctx context.Context, budgetID,
+	categoryID string, f *Filter) (*HybridSearchResultSnapshot, error) {
+
 	resModel := struct {
 		Data struct {
-			Transactions []*Hybrid `json:"transactions"`
+			Transactions    []*Hybrid `json:"transactions"`
+			ServerKnowledge uint64    `json:"server_knowledge"`
 		} `json:"data"`
 	}{}
 
@@ -241,11 +582,13 @@ func (s *Service) GetTransactionsByCategory(budgetID, categoryID string,
 		url = fmt.Sprintf("%s?%s", url, f.ToQuery())
 	}
 
-	if err := s.c.GET(url, &resModel); err != nil {
+	if err := s.c.GETWithContext(ctx, url, &resModel); err != nil {
 		return nil, err
 	}
-
-	return resModel.Data.Transactions, nil
+	return &HybridSearchResultSnapshot{
+		Transactions:    resModel.Data.Transactions,
+		ServerKnowledge: resModel.Data.ServerKnowledge,
+	}, nil
 }
 
 // GetTransactionsByPayee fetches the list of transactions of a specific payee
@@ -254,9 +597,45 @@ func (s *Service) GetTransactionsByCategory(budgetID, categoryID string,
 func (s *Service) GetTransactionsByPayee(budgetID, payeeID string,
 	f *Filter) ([]*Hybrid, error) {
 
+	return s.GetTransactionsByPayeeWithContext(context.Background(), budgetID, payeeID, f)
+}
+
+// GetTransactionsByPayeeWithContext fetches the list of transactions of
+// a specific payee from a budget with filtering capabilities bound to ctx
+// https://api.youneedabudget.com/v1#/Transactions/getTransactionsByPayee
+func (s *Service) GetTransactionsByPayeeWithContext(ctx context.Context, budgetID,
+	payeeID string, f *Filter) ([]*Hybrid, error) {
+
+	snapshot, err := s.GetTransactionsByPayeeSnapshotWithContext(ctx, budgetID, payeeID, f)
+	if err != nil {
+		return nil, err
+	}
+	return snapshot.Transactions, nil
+}
+
+// GetTransactionsByPayeeSnapshot fetches the list of transactions of a
+// specific payee from a budget with filtering capabilities, along with
+// the server knowledge to be used in the next delta request
+// https://api.youneedabudget.com/v1#/Transactions/getTransactionsByPayee
+func (s *Service) GetTransactionsByPayeeSnapshot(budgetID, payeeID string,
+	f *Filter) (*HybridSearchResultSnapshot, error) {
+
+	return s.GetTransactionsByPayeeSnapshotWithContext(context.Background(),
+		budgetID, payeeID, f)
+}
+
+// GetTransactionsByPayeeSnapshotWithContext fetches the list of
+// transactions of a specific payee from a budget with filtering
+// capabilities, along with the server knowledge to be used in the next
+// delta request bound to ctx
+// https://api.youneedabudget.com/v1#/Transactions/getTransactionsByPayee
+func (s *Service) GetTransactionsByPayeeSnapshotWithContext(ctx context.Context, budgetID,
+	payeeID string, f *Filter) (*HybridSearchResultSnapshot, error) {
+
 	resModel := struct {
 		Data struct {
-			Transactions []*Hybrid `json:"transactions"`
+			Transactions    []*Hybrid `json:"transactions"`
+			ServerKnowledge uint64    `json:"server_knowledge"`
 		} `json:"data"`
 	}{}
 
@@ -265,34 +644,197 @@ func (s *Service) GetTransactionsByPayee(budgetID, payeeID string,
 		url = fmt.Sprintf("%s?%s", url, f.ToQuery())
 	}
 
-	if err := s.c.GET(url, &resModel); err != nil {
+	if err := s.c.GETWithContext(ctx, url, &resModel); err != nil {
 		return nil, err
 	}
+	return &HybridSearchResultSnapshot{
+		Transactions:    resModel.Data.Transactions,
+		ServerKnowledge: resModel.Data.ServerKnowledge,
+	}, nil
+}
 
-	return resModel.Data.Transactions, nil
+// GetTransactionsByMonth fetches the list of transactions of a specific
+// month from a budget with filtering capabilities
+// https://api.youneedabudget.com/v1#/Transactions/getTransactionsByMonth
+func (s *Service) GetTransactionsByMonth(budgetID string, month api.Date,
+	f *Filter) ([]*Transaction, error) {
+
+	return s.GetTransactionsByMonthWithContext(context.Background(), budgetID, month, f)
+}
+
+// GetTransactionsByMonthWithContext fetches the list of transactions of
+// a specific month from a budget with filtering capabilities bound to ctx
+// https://api.youneedabudget.com/v1#/Transactions/getTransactionsByMonth
+func (s *Service) GetTransactionsByMonthWithContext(ctx context.Context, budgetID string,
+	month api.Date, f *Filter) ([]*Transaction, error) {
+
+	snapshot, err := s.getTransactionsByMonth(ctx, budgetID, api.DateFormat(month), f)
+	if err != nil {
+		return nil, err
+	}
+	return snapshot.Transactions, nil
+}
+
+// GetTransactionsByMonthSnapshot fetches the list of transactions of a
+// specific month from a budget with filtering capabilities, along with
+// the server knowledge to be used in the next delta request
+// https://api.youneedabudget.com/v1#/Transactions/getTransactionsByMonth
+func (s *Service) GetTransactionsByMonthSnapshot(budgetID string, month api.Date,
+	f *Filter) (*SearchResultSnapshot, error) {
+
+	return s.getTransactionsByMonth(context.Background(), budgetID,
+		api.DateFormat(month), f)
+}
+
+// GetTransactionsByMonthSnapshotWithContext fetches the list of
+// transactions of a specific month from a budget with filtering
+// capabilities, along with the server knowledge to be used in the next
+// delta request bound to ctx
+// https://api.youneedabudget.com/v1#/Transactions/getTransactionsByMonth
+func (s *Service) GetTransactionsByMonthSnapshotWithContext(ctx context.Context,
+	budgetID string, month api.Date, f *Filter) (*SearchResultSnapshot, error) {
+
+	return s.getTransactionsByMonth(ctx, budgetID, api.DateFormat(month), f)
+}
+
+// GetTransactionsByCurrentMonth fetches the list of transactions of the
+// current month from a budget with filtering capabilities
+// https://api.youneedabudget.com/v1#/Transactions/getTransactionsByMonth
+func (s *Service) GetTransactionsByCurrentMonth(budgetID string,
+	f *Filter) ([]*Transaction, error) {
+
+	return s.GetTransactionsByCurrentMonthWithContext(context.Background(), budgetID, f)
+}
+
+// GetTransactionsByCurrentMonthWithContext fetches the list of transactions
+// of the current month from a budget with filtering capabilities bound to ctx
+// https://api.youneedabudget.com/v1#/Transactions/getTransactionsByMonth
+func (s *Service) GetTransactionsByCurrentMonthWithContext(ctx context.Context,
+	budgetID string, f *Filter) ([]*Transaction, error) {
+
+	snapshot, err := s.getTransactionsByMonth(ctx, budgetID, currentMonthID, f)
+	if err != nil {
+		return nil, err
+	}
+	return snapshot.Transactions, nil
+}
+
+// GetTransactionsByCurrentMonthSnapshot fetches the list of transactions
+// of the current month from a budget with filtering capabilities, along
+// with the server knowledge to be used in the next delta request
+// https://api.youneedabudget.com/v1#/Transactions/getTransactionsByMonth
+func (s *Service) GetTransactionsByCurrentMonthSnapshot(budgetID string,
+	f *Filter) (*SearchResultSnapshot, error) {
+
+	return s.getTransactionsByMonth(context.Background(), budgetID, currentMonthID, f)
+}
+
+// GetTransactionsByCurrentMonthSnapshotWithContext fetches the list of
+// transactions of the current month from a budget with filtering
+// capabilities, along with the server knowledge to be used in the next
+// delta request bound to ctx
+// https://api.youneedabudget.com/v1#/Transactions/getTransactionsByMonth
+func (s *Service) GetTransactionsByCurrentMonthSnapshotWithContext(ctx context.Context,
+	budgetID string, f *Filter) (*SearchResultSnapshot, error) {
+
+	return s.getTransactionsByMonth(ctx, budgetID, currentMonthID, f)
+}
+
+func (s *Service) getTransactionsByMonth(ctx context.Context, budgetID,
+	month string, f *Filter) (*SearchResultSnapshot, error) {
+
+	resModel := struct {
+		Data struct {
+			Transactions    []*Transaction `json:"transactions"`
+			ServerKnowledge uint64         `json:"server_knowledge"`
+		} `json:"data"`
+	}{}
+
+	url := fmt.Sprintf("/budgets/%s/months/%s/transactions", budgetID, month)
+	if f != nil {
+		url = fmt.Sprintf("%s?%s", url, f.ToQuery())
+	}
+
+	if err := s.c.GETWithContext(ctx, url, &resModel); err != nil {
+		return nil, err
+	}
+	return &SearchResultSnapshot{
+		Transactions:    resModel.Data.Transactions,
+		ServerKnowledge: resModel.Data.ServerKnowledge,
+	}, nil
 }
 
 // GetScheduledTransactions fetches the list of scheduled transactions from
 // a budget
 // https://api.youneedabudget.com/v1#/Scheduled_Transactions/getScheduledTransactions
 func (s *Service) GetScheduledTransactions(budgetID string) ([]*Scheduled, error) {
+	return s.GetScheduledTransactionsWithContext(context.Background(), budgetID)
+}
+
+// GetScheduledTransactionsWithContext fetches the list of scheduled
+// transactions from a budget bound to ctx
+// https://api.youneedabudget.com/v1#/Scheduled_Transactions/getScheduledTransactions
+func (s *Service) GetScheduledTransactionsWithContext(ctx context.Context,
+	budgetID string) ([]*Scheduled, error) {
+
+	snapshot, err := s.GetScheduledTransactionsSnapshotWithContext(ctx, budgetID, nil)
+	if err != nil {
+		return nil, err
+	}
+	return snapshot.ScheduledTransactions, nil
+}
+
+// GetScheduledTransactionsSnapshot fetches the list of scheduled
+// transactions from a budget, along with the server knowledge to be used in
+// the next delta request
+// https://api.youneedabudget.com/v1#/Scheduled_Transactions/getScheduledTransactions
+func (s *Service) GetScheduledTransactionsSnapshot(budgetID string,
+	f *api.Filter) (*ScheduledSearchResultSnapshot, error) {
+
+	return s.GetScheduledTransactionsSnapshotWithContext(context.Background(), budgetID, f)
+}
+
+// GetScheduledTransactionsSnapshotWithContext fetches the list of scheduled
+// transactions from a budget, along with the server knowledge to be used in
+// the next delta request bound to ctx
+// https://api.youneedabudget.com/v1#/Scheduled_Transactions/getScheduledTransactions
+func (s *Service) GetScheduledTransactionsSnapshotWithContext(ctx context.Context,
+	budgetID string, f *api.Filter) (*ScheduledSearchResultSnapshot, error) {
+
 	resModel := struct {
 		Data struct {
 			ScheduledTransactions []*Scheduled `json:"scheduled_transactions"`
+			ServerKnowledge       uint64       `json:"server_knowledge"`
 		} `json:"data"`
 	}{}
 
 	url := fmt.Sprintf("/budgets/%s/scheduled_transactions", budgetID)
-	if err := s.c.GET(url, &resModel); err != nil {
-		return nil, err
+	if f != nil {
+		url = fmt.Sprintf("%s?%s", url, f.ToQuery())
 	}
 
-	return resModel.Data.ScheduledTransactions, nil
+	if err := s.c.GETWithContext(ctx, url, &resModel); err != nil {
+		return nil, err
+	}
+	return &ScheduledSearchResultSnapshot{
+		ScheduledTransactions: resModel.Data.ScheduledTransactions,
+		ServerKnowledge:       resModel.Data.ServerKnowledge,
+	}, nil
 }
 
 // GetScheduledTransaction fetches a specific scheduled transaction from a budget
 // https://api.youneedabudget.com/v1#/Scheduled_Transactions/getScheduledTransactionById
 func (s *Service) GetScheduledTransaction(budgetID, scheduledTransactionID string) (*Scheduled, error) {
+	return s.GetScheduledTransactionWithContext(context.Background(), budgetID,
+		scheduledTransactionID)
+}
+
+// GetScheduledTransactionWithContext fetches a specific scheduled transaction
+// from a budget bound to ctx
+// https://api.youneedabudget.com/v1#/Scheduled_Transactions/getScheduledTransactionById
+func (s *Service) GetScheduledTransactionWithContext(ctx context.Context, budgetID,
+	scheduledTransactionID string) (*Scheduled, error) {
+
 	resModel := struct {
 		Data struct {
 			ScheduledTransactions *Scheduled `json:"scheduled_transaction"`
@@ -300,21 +842,40 @@ func (s *Service) GetScheduledTransaction(budgetID, scheduledTransactionID strin
 	}{}
 
 	url := fmt.Sprintf("/budgets/%s/scheduled_transactions/%s", budgetID, scheduledTransactionID)
-	if err := s.c.GET(url, &resModel); err != nil {
+	if err := s.c.GETWithContext(ctx, url, &resModel); err != nil {
 		return nil, err
 	}
 	return resModel.Data.ScheduledTransactions, nil
 }
 
+// hasImportIDs tells whether every transaction of the payload carries
+// an ImportID
+func hasImportIDs(p []PayloadTransaction) bool {
+	if len(p) == 0 {
+		return false
+	}
+	for _, tx := range p {
+		if tx.ImportID == nil || *tx.ImportID == "" {
+			return false
+		}
+	}
+	return true
+}
+
 // Filter represents the optional filter while fetching transactions
 type Filter struct {
 	Since *api.Date
 	Type  *Status
+	// LastKnowledgeOfServer The starting server knowledge. If provided,
+	// only transactions that have changed since last_knowledge_of_server
+	// will be included. Use it with the Snapshot methods, which return the
+	// server knowledge to be used in the next delta request.
+	LastKnowledgeOfServer uint64
 }
 
 // ToQuery returns the filters as a HTTP query string
 func (f *Filter) ToQuery() string {
-	pairs := make([]string, 0, 2)
+	pairs := make([]string, 0, 3)
 	if f.Since != nil && !f.Since.IsZero() {
 		pairs = append(pairs, fmt.Sprintf("since_date=%s",
 			api.DateFormat(*f.Since)))
@@ -322,5 +883,125 @@ func (f *Filter) ToQuery() string {
 	if f.Type != nil {
 		pairs = append(pairs, fmt.Sprintf("type=%s", string(*f.Type)))
 	}
+	if f.LastKnowledgeOfServer > 0 {
+		pairs = append(pairs, fmt.Sprintf("last_knowledge_of_server=%d",
+			f.LastKnowledgeOfServer))
+	}
 	return strings.Join(pairs, "&")
 }
+
+// CreateScheduledTransaction creates a new scheduled transaction for a budget
+// https://api.youneedabudget.com/v1#/Scheduled_Transactions/createScheduledTransaction
+func (s *Service) CreateScheduledTransaction(budgetID string,
+	p PayloadScheduledTransaction) (*Scheduled, error) {
+
+	return s.CreateScheduledTransactionWithContext(context.Background(), budgetID, p)
+}
+
+// CreateScheduledTransactionWithContext creates a new scheduled transaction
+// for a budget bound to ctx
+// https://api.youneedabudget.com/v1#/Scheduled_Transactions/createScheduledTransaction
+func (s *Service) CreateScheduledTransactionWithContext(ctx context.Context, budgetID string,
+	p PayloadScheduledTransaction) (*Scheduled, error) {
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	payload := struct {
+		ScheduledTransaction *PayloadScheduledTransaction `json:"scheduled_transaction"`
+	}{
+		&p,
+	}
+
+	buf, err := json.Marshal(&payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resModel := struct {
+		Data struct {
+			ScheduledTransaction *Scheduled `json:"scheduled_transaction"`
+		} `json:"data"`
+	}{}
+
+	url := fmt.Sprintf("/budgets/%s/scheduled_transactions", budgetID)
+	if err := s.c.POSTWithContext(ctx, url, &resModel, buf); err != nil {
+		return nil, err
+	}
+	return resModel.Data.ScheduledTransaction, nil
+}
+
+// UpdateScheduledTransaction updates a whole scheduled transaction for a
+// replacement
+// https://api.youneedabudget.com/v1#/Scheduled_Transactions/updateScheduledTransaction
+func (s *Service) UpdateScheduledTransaction(budgetID, scheduledTransactionID string,
+	p PayloadScheduledTransaction) (*Scheduled, error) {
+
+	return s.UpdateScheduledTransactionWithContext(context.Background(), budgetID,
+		scheduledTransactionID, p)
+}
+
+// UpdateScheduledTransactionWithContext updates a whole scheduled transaction
+// for a replacement bound to ctx
+// https://api.youneedabudget.com/v1#/Scheduled_Transactions/updateScheduledTransaction
+func (s *Service) UpdateScheduledTransactionWithContext(ctx context.Context, budgetID,
+	scheduledTransactionID string, p PayloadScheduledTransaction) (*Scheduled, error) {
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	payload := struct {
+		ScheduledTransaction *PayloadScheduledTransaction `json:"scheduled_transaction"`
+	}{
+		&p,
+	}
+
+	buf, err := json.Marshal(&payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resModel := struct {
+		Data struct {
+			ScheduledTransaction *Scheduled `json:"scheduled_transaction"`
+		} `json:"data"`
+	}{}
+
+	url := fmt.Sprintf("/budgets/%s/scheduled_transactions/%s", budgetID,
+		scheduledTransactionID)
+	if err := s.c.PUTWithContext(ctx, url, &resModel, buf); err != nil {
+		return nil, err
+	}
+	return resModel.Data.ScheduledTransaction, nil
+}
+
+// DeleteScheduledTransaction deletes a scheduled transaction from a budget
+// https://api.youneedabudget.com/v1#/Scheduled_Transactions/deleteScheduledTransaction
+func (s *Service) DeleteScheduledTransaction(budgetID,
+	scheduledTransactionID string) (*Scheduled, error) {
+
+	return s.DeleteScheduledTransactionWithContext(context.Background(), budgetID,
+		scheduledTransactionID)
+}
+
+// DeleteScheduledTransactionWithContext deletes a scheduled transaction from
+// a budget bound to ctx
+// https://api.youneedabudget.com/v1#/Scheduled_Transactions/deleteScheduledTransaction
+func (s *Service) DeleteScheduledTransactionWithContext(ctx context.Context, budgetID,
+	scheduledTransactionID string) (*Scheduled, error) {
+
+	resModel := struct {
+		Data struct {
+			ScheduledTransaction *Scheduled `json:"scheduled_transaction"`
+		} `json:"data"`
+	}{}
+
+	url := fmt.Sprintf("/budgets/%s/scheduled_transactions/%s", budgetID,
+		scheduledTransactionID)
+	if err := s.c.DELETEWithContext(ctx, url, &resModel); err != nil {
+		return nil, err
+	}
+	return resModel.Data.ScheduledTransaction, nil
+}
@@ -5,7 +5,9 @@
 package transaction_test
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"testing"
 
@@ -208,6 +210,236 @@ func TestService_GetTransaction(t *testing.T) {
 	assert.Equal(t, expected, tx)
 }
 
+func TestService_ImportTransactions(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/transactions"
+	httpmock.RegisterResponder(http.MethodPost, url+"/import",
+		func(req *http.Request) (*http.Response, error) {
+			res := httpmock.NewStringResponse(201, `{
+  "data": {
+    "transaction_ids": [
+      "0f5b3f73-ded2-4dd7-8b01-c23022622cd6",
+      "e6ad88f5-6f16-4480-9515-5377012750dd",
+      "7c9d1e2f-3a4b-4c5d-8e6f-1a2b3c4d5e6f"
+    ]
+  }
+}
+		`)
+			return res, nil
+		},
+	)
+	httpmock.RegisterResponder(http.MethodGet, url+"?type=unapproved",
+		func(req *http.Request) (*http.Response, error) {
+			res := httpmock.NewStringResponse(200, `{
+  "data": {
+    "transactions": [
+      {
+        "id": "e6ad88f5-6f16-4480-9515-5377012750dd",
+        "date": "2018-11-13",
+        "amount": -2000,
+        "approved": false
+      },
+      {
+        "id": "5a1f7b2c-1a4e-4b3d-8c2a-9e4d3c2b1a0f",
+        "date": "2018-11-12",
+        "amount": -1000,
+        "approved": false
+      },
+      {
+        "id": "0f5b3f73-ded2-4dd7-8b01-c23022622cd6",
+        "date": "2018-11-13",
+        "amount": -9000,
+        "approved": false
+      }
+    ]
+  }
+}
+		`)
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	summary, err := client.Transaction().ImportTransactions("aa248caa-eed7-4575-a990-717386438d2c")
+	assert.NoError(t, err)
+
+	expectedIDs := []string{
+		"0f5b3f73-ded2-4dd7-8b01-c23022622cd6",
+		"e6ad88f5-6f16-4480-9515-5377012750dd",
+		"7c9d1e2f-3a4b-4c5d-8e6f-1a2b3c4d5e6f",
+	}
+	assert.Equal(t, expectedIDs, summary.TransactionIDs)
+	if assert.Len(t, summary.Transactions, 2) {
+		assert.Equal(t, expectedIDs[0], summary.Transactions[0].ID)
+		assert.Equal(t, int64(-9000), summary.Transactions[0].Amount)
+		assert.Equal(t, expectedIDs[1], summary.Transactions[1].ID)
+	}
+	assert.Equal(t, []string{"7c9d1e2f-3a4b-4c5d-8e6f-1a2b3c4d5e6f"},
+		summary.NotFoundTransactionIDs)
+}
+
+func TestService_ImportTransactions_fetchError(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/transactions"
+	httpmock.RegisterResponder(http.MethodPost, url+"/import",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(201, `{"data":{"transaction_ids":["0f5b3f73-ded2-4dd7-8b01-c23022622cd6"]}}`), nil
+		},
+	)
+	httpmock.RegisterResponder(http.MethodGet, url+"?type=unapproved",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(500, `{
+  "error": {
+    "id": "500",
+    "name": "internal_server_error",
+    "detail": "Internal server error"
+  }
+}`), nil
+		},
+	)
+
+	client := ynab.NewClient("", ynab.WithRetryPolicy(ynab.RetryPolicy{MaxAttempts: 1}))
+	summary, err := client.Transaction().ImportTransactions("aa248caa-eed7-4575-a990-717386438d2c")
+	assert.True(t, errors.Is(err, api.ErrInternalServer))
+	if assert.NotNil(t, summary) {
+		assert.Equal(t, []string{"0f5b3f73-ded2-4dd7-8b01-c23022622cd6"}, summary.TransactionIDs)
+		assert.Empty(t, summary.Transactions)
+	}
+}
+
+func TestService_ImportTransactions_nothingImported(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/transactions/import"
+	httpmock.RegisterResponder(http.MethodPost, url,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(200, `{"data":{"transaction_ids":[]}}`), nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	summary, err := client.Transaction().ImportTransactions("aa248caa-eed7-4575-a990-717386438d2c")
+	assert.NoError(t, err)
+
+	expected := &transaction.ImportSummary{
+		TransactionIDs: []string{},
+		Transactions:   []*transaction.Transaction{},
+	}
+	assert.Equal(t, expected, summary)
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+func TestService_GetTransaction_debtAndMatching(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/transactions/e6ad88f5-6f16-4480-9515-5377012750dd"
+	httpmock.RegisterResponder(http.MethodGet, url,
+		func(req *http.Request) (*http.Response, error) {
+			res := httpmock.NewStringResponse(200, `{
+  "data": {
+    "transaction": {
+      "id": "e6ad88f5-6f16-4480-9515-5377012750dd",
+      "date": "2018-11-13",
+      "amount": -350000,
+      "cleared": "cleared",
+      "approved": true,
+      "flag_color": "green",
+      "flag_name": "Reviewed",
+      "account_id": "09eaca5e-312a-4bcd-89c4-828fb90638f2",
+      "account_name": "Car Loan",
+      "transfer_account_id": "5a1f7b2c-1a4e-4b3d-8c2a-9e4d3c2b1a0f",
+      "transfer_transaction_id": "0f5b3f73-ded2-4dd7-8b01-c23022622cd6",
+      "matched_transaction_id": "7c9b4d1e-56af-4b6f-9c37-2b7c6a5c1a0e",
+      "import_payee_name": "Auto Finance",
+      "import_payee_name_original": "AUTO FIN PMT 0042",
+      "debt_transaction_type": "payment",
+      "deleted": false,
+      "subtransactions": [
+        {
+          "id": "b1c2d3e4-0000-4000-8000-000000000001",
+          "transaction_id": "e6ad88f5-6f16-4480-9515-5377012750dd",
+          "amount": -350000,
+          "payee_name": "Auto Finance",
+          "category_name": "Car Payment",
+          "transfer_transaction_id": null,
+          "deleted": false
+        }
+      ]
+    }
+  }
+}
+		`)
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	tx, err := client.Transaction().GetTransaction(
+		"aa248caa-eed7-4575-a990-717386438d2c",
+		"e6ad88f5-6f16-4480-9515-5377012750dd",
+	)
+	assert.NoError(t, err)
+
+	expectedDate, err := api.DateFromString("2018-11-13")
+	assert.NoError(t, err)
+
+	var (
+		flagColor               = transaction.FlagColorGreen
+		flagName                = "Reviewed"
+		transferAccountID       = "5a1f7b2c-1a4e-4b3d-8c2a-9e4d3c2b1a0f"
+		transferTransactionID   = "0f5b3f73-ded2-4dd7-8b01-c23022622cd6"
+		matchedTransactionID    = "7c9b4d1e-56af-4b6f-9c37-2b7c6a5c1a0e"
+		importPayeeName         = "Auto Finance"
+		importPayeeNameOriginal = "AUTO FIN PMT 0042"
+		debtTransactionType     = transaction.DebtTransactionTypePayment
+		subCategoryName         = "Car Payment"
+	)
+
+	expected := &transaction.Transaction{
+		ID:                      "e6ad88f5-6f16-4480-9515-5377012750dd",
+		Date:                    expectedDate,
+		Amount:                  int64(-350000),
+		Cleared:                 transaction.ClearingStatusCleared,
+		Approved:                true,
+		FlagColor:               &flagColor,
+		FlagName:                &flagName,
+		AccountID:               "09eaca5e-312a-4bcd-89c4-828fb90638f2",
+		AccountName:             "Car Loan",
+		TransferAccountID:       &transferAccountID,
+		TransferTransactionID:   &transferTransactionID,
+		MatchedTransactionID:    &matchedTransactionID,
+		ImportPayeeName:         &importPayeeName,
+		ImportPayeeNameOriginal: &importPayeeNameOriginal,
+		DebtTransactionType:     &debtTransactionType,
+		SubTransactions: []*transaction.SubTransaction{
+			{
+				ID:            "b1c2d3e4-0000-4000-8000-000000000001",
+				TransactionID: "e6ad88f5-6f16-4480-9515-5377012750dd",
+				Amount:        int64(-350000),
+				PayeeName:     &importPayeeName,
+				CategoryName:  &subCategoryName,
+			},
+		},
+	}
+	assert.Equal(t, expected, tx)
+}
+
+func TestPayloadTransaction_flagName(t *testing.T) {
+	flagName := "Reviewed"
+	buf, err := json.Marshal(transaction.PayloadTransaction{FlagName: &flagName})
+	assert.NoError(t, err)
+
+	payload := map[string]interface{}{}
+	assert.NoError(t, json.Unmarshal(buf, &payload))
+	assert.Equal(t, "Reviewed", payload["flag_name"])
+}
+
 func TestService_GetTransactionsByAccount(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
@@ -533,34 +765,25 @@ func TestService_GetScheduledTransactions(t *testing.T) {
 	assert.Equal(t, expected, transactions)
 }
 
-func TestService_GetScheduledTransaction(t *testing.T) {
+func TestService_GetTransactionsSnapshot(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
 
-	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/scheduled_transactions/56f4fc86-2ed7-4b3b-9116-7a214261b3cd"
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/transactions?last_knowledge_of_server=10"
 	httpmock.RegisterResponder(http.MethodGet, url,
 		func(req *http.Request) (*http.Response, error) {
 			res := httpmock.NewStringResponse(200, `{
   "data": {
-    "scheduled_transaction": {
-			"id": "56f4fc86-2ed7-4b3b-9116-7a214261b3cd",
-			"date_first": "2018-11-13",
-			"date_next": "2018-11-13",
-			"frequency": "never",
-			"amount": -9000,
-			"memo": "nice memo",
-			"flag_color": "yellow",
-			"account_id": "09eaca5e-312a-4bcd-89c4-828fb90638f2",
-			"account_name": "Bank Name",
-			"payee_id": "0d0e928d-312a-4bcd-89c4-e02f40d1fe46",
-			"payee_name": "bla bla bla",
-			"category_id": "f3cc4f55-312a-4bcd-89c4-db34379cb1dc",
-			"category_name": "Yearly subscription",
-			"transfer_account_id": null,
-			"deleted": false,
-			"subtransactions": []
-    }
-	}
+    "transactions": [
+      {
+        "id": "e6ad88f5-6f16-4480-9515-5377012750dd",
+        "date": "2018-11-13",
+        "amount": -2000,
+        "deleted": true
+      }
+    ],
+    "server_knowledge": 12
+  }
 }
 		`)
 			return res, nil
@@ -568,100 +791,52 @@ func TestService_GetScheduledTransaction(t *testing.T) {
 	)
 
 	client := ynab.NewClient("")
-	stx, err := client.Transaction().GetScheduledTransaction(
+	snapshot, err := client.Transaction().GetTransactionsSnapshot(
 		"aa248caa-eed7-4575-a990-717386438d2c",
-		"56f4fc86-2ed7-4b3b-9116-7a214261b3cd",
+		&transaction.Filter{LastKnowledgeOfServer: 10},
 	)
 	assert.NoError(t, err)
 
-	expectedFirstAndLastDate, err := api.DateFromString("2018-11-13")
+	expectedDate, err := api.DateFromString("2018-11-13")
 	assert.NoError(t, err)
 
-	expectedMemo := "nice memo"
-	expectedFlagColor := transaction.FlagColorYellow
-	expectedPayeeID := "0d0e928d-312a-4bcd-89c4-e02f40d1fe46"
-	expectedPayeeName := "bla bla bla"
-	expectedCategoryID := "f3cc4f55-312a-4bcd-89c4-db34379cb1dc"
-	expectedCategoryName := "Yearly subscription"
-
-	expected := &transaction.Scheduled{
-		ID:              "56f4fc86-2ed7-4b3b-9116-7a214261b3cd",
-		DateFirst:       expectedFirstAndLastDate,
-		DateNext:        expectedFirstAndLastDate,
-		Frequency:       transaction.FrequencyNever,
-		Amount:          int64(-9000),
-		Memo:            &expectedMemo,
-		FlagColor:       &expectedFlagColor,
-		AccountID:       "09eaca5e-312a-4bcd-89c4-828fb90638f2",
-		AccountName:     "Bank Name",
-		PayeeID:         &expectedPayeeID,
-		PayeeName:       &expectedPayeeName,
-		CategoryID:      &expectedCategoryID,
-		CategoryName:    &expectedCategoryName,
-		Deleted:         false,
-		SubTransactions: []*transaction.ScheduledSubTransaction{},
+	expected := &transaction.SearchResultSnapshot{
+		Transactions: []*transaction.Transaction{
+			{
+				ID:      "e6ad88f5-6f16-4480-9515-5377012750dd",
+				Date:    expectedDate,
+				Amount:  int64(-2000),
+				Deleted: true,
+			},
+		},
+		ServerKnowledge: uint64(12),
 	}
-	assert.Equal(t, expected, stx)
+	assert.Equal(t, expected, snapshot)
 }
 
-func TestService_CreateTransaction(t *testing.T) {
+func TestService_GetTransactionsByMonth(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
 
-	payloadDate, err := api.DateFromString("2018-11-13")
-	assert.NoError(t, err)
-
-	payloadPayeeID := "0d0e928d-312a-4bcd-89c4-e02f40d1fe46"
-	payloadPayeeName := "bla bla bla"
-	payloadCategoryID := "f3cc4f55-312a-4bcd-89c4-db34379cb1dc"
-	payloadMemo := "nice memo"
-	payloadFlagColor := transaction.FlagColorBlue
-
-	payload := transaction.PayloadTransaction{
-		AccountID:  "09eaca5e-312a-4bcd-89c4-828fb90638f2",
-		Date:       payloadDate,
-		Amount:     int64(-9000),
-		Cleared:    transaction.ClearingStatusCleared,
-		Approved:   true,
-		PayeeID:    &payloadPayeeID,
-		PayeeName:  &payloadPayeeName,
-		CategoryID: &payloadCategoryID,
-		Memo:       &payloadMemo,
-		FlagColor:  &payloadFlagColor,
-	}
-
-	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/transactions"
-	httpmock.RegisterResponder(http.MethodPost, url,
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/months/2018-11-01/transactions"
+	httpmock.RegisterResponder(http.MethodGet, url,
 		func(req *http.Request) (*http.Response, error) {
-			resModel := struct {
-				Data *transaction.OperationSummary `json:"data"`
-			}{}
-			err := json.NewDecoder(req.Body).Decode(&resModel)
-			assert.NoError(t, err)
-
 			res := httpmock.NewStringResponse(200, `{
   "data": {
-		"transaction_ids": ["0f5b3f73-ded2-4dd7-8b01-c23022622cd6"],
-		"duplicate_import_ids": [],
-    "transaction": {
-			"id": "0f5b3f73-ded2-4dd7-8b01-c23022622cd6",
-      "date": "2018-11-13",
-      "amount": -9000,
-      "memo": "nice memo",
-      "cleared": "cleared",
-      "approved": true,
-      "flag_color": "blue",
-      "account_id": "09eaca5e-312a-4bcd-89c4-828fb90638f2",
-      "account_name": "Bank Name",
-      "payee_id": "0d0e928d-312a-4bcd-89c4-e02f40d1fe46",
-      "payee_name": "bla bla bla",
-      "category_id": "f3cc4f55-312a-4bcd-89c4-db34379cb1dc",
-      "category_name": "Groceries",
-      "transfer_account_id": null,
-      "import_id": null,
-      "deleted": false,
-      "subtransactions": []
-		}
+    "transactions": [
+      {
+        "id": "e6ad88f5-6f16-4480-9515-5377012750dd",
+        "date": "2018-11-13",
+        "amount": -2000,
+        "cleared": "cleared",
+        "approved": true,
+        "account_id": "09eaca5e-312a-4bcd-89c4-828fb90638f2",
+        "account_name": "Bank Name",
+        "deleted": false,
+        "subtransactions": []
+      }
+    ],
+    "server_knowledge": 12
   }
 }
 		`)
@@ -669,17 +844,467 @@ func TestService_CreateTransaction(t *testing.T) {
 		},
 	)
 
+	month, err := api.DateFromString("2018-11-01")
+	assert.NoError(t, err)
+
 	client := ynab.NewClient("")
-	tx, err := client.Transaction().CreateTransaction("aa248caa-eed7-4575-a990-717386438d2c", payload)
+	transactions, err := client.Transaction().GetTransactionsByMonth(
+		"aa248caa-eed7-4575-a990-717386438d2c", month, nil)
 	assert.NoError(t, err)
 
-	expectedCategoryName := "Groceries"
-	expectedTransactions := &transaction.OperationSummary{
-		TransactionIDs: []string{
-			"0f5b3f73-ded2-4dd7-8b01-c23022622cd6",
-		},
-		DuplicateImportIDs: []string{},
-		Transaction: &transaction.Transaction{
+	expectedDate, err := api.DateFromString("2018-11-13")
+	assert.NoError(t, err)
+
+	expected := []*transaction.Transaction{
+		{
+			ID:              "e6ad88f5-6f16-4480-9515-5377012750dd",
+			Date:            expectedDate,
+			Amount:          int64(-2000),
+			Cleared:         transaction.ClearingStatusCleared,
+			Approved:        true,
+			AccountID:       "09eaca5e-312a-4bcd-89c4-828fb90638f2",
+			AccountName:     "Bank Name",
+			SubTransactions: []*transaction.SubTransaction{},
+		},
+	}
+	assert.Equal(t, expected, transactions)
+}
+
+func TestService_GetTransactionsByCurrentMonth(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/months/current/transactions?type=unapproved"
+	httpmock.RegisterResponder(http.MethodGet, url,
+		func(req *http.Request) (*http.Response, error) {
+			res := httpmock.NewStringResponse(200, `{
+  "data": {
+    "transactions": [
+      {
+        "id": "e6ad88f5-6f16-4480-9515-5377012750dd",
+        "approved": false
+      }
+    ],
+    "server_knowledge": 12
+  }
+}
+		`)
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	transactions, err := client.Transaction().GetTransactionsByCurrentMonth(
+		"aa248caa-eed7-4575-a990-717386438d2c",
+		&transaction.Filter{Type: transaction.StatusUnapproved.Pointer()},
+	)
+	assert.NoError(t, err)
+
+	expected := []*transaction.Transaction{
+		{ID: "e6ad88f5-6f16-4480-9515-5377012750dd"},
+	}
+	assert.Equal(t, expected, transactions)
+}
+
+func TestService_GetScheduledTransactionsSnapshot(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/scheduled_transactions?last_knowledge_of_server=10"
+	httpmock.RegisterResponder(http.MethodGet, url,
+		func(req *http.Request) (*http.Response, error) {
+			res := httpmock.NewStringResponse(200, `{
+  "data": {
+    "scheduled_transactions": [
+      {
+        "id": "56f4fc86-2ed7-4b3b-9116-7a214261b3cd",
+        "date_first": "2018-11-13",
+        "date_next": "2018-12-13",
+        "frequency": "monthly",
+        "amount": -9000,
+        "deleted": false
+      }
+    ],
+    "server_knowledge": 12
+  }
+}
+		`)
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	snapshot, err := client.Transaction().GetScheduledTransactionsSnapshot(
+		"aa248caa-eed7-4575-a990-717386438d2c",
+		&api.Filter{LastKnowledgeOfServer: 10},
+	)
+	assert.NoError(t, err)
+
+	expectedDateFirst, err := api.DateFromString("2018-11-13")
+	assert.NoError(t, err)
+	expectedDateNext, err := api.DateFromString("2018-12-13")
+	assert.NoError(t, err)
+
+	expected := &transaction.ScheduledSearchResultSnapshot{
+		ScheduledTransactions: []*transaction.Scheduled{
+			{
+				ID:        "56f4fc86-2ed7-4b3b-9116-7a214261b3cd",
+				DateFirst: expectedDateFirst,
+				DateNext:  expectedDateNext,
+				Frequency: transaction.FrequencyMonthly,
+				Amount:    int64(-9000),
+			},
+		},
+		ServerKnowledge: uint64(12),
+	}
+	assert.Equal(t, expected, snapshot)
+}
+
+func TestService_GetScheduledTransaction(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/scheduled_transactions/56f4fc86-2ed7-4b3b-9116-7a214261b3cd"
+	httpmock.RegisterResponder(http.MethodGet, url,
+		func(req *http.Request) (*http.Response, error) {
+			res := httpmock.NewStringResponse(200, `{
+  "data": {
+    "scheduled_transaction": {
+			"id": "56f4fc86-2ed7-4b3b-9116-7a214261b3cd",
+			"date_first": "2018-11-13",
+			"date_next": "2018-11-13",
+			"frequency": "never",
+			"amount": -9000,
+			"memo": "nice memo",
+			"flag_color": "yellow",
+			"account_id": "09eaca5e-312a-4bcd-89c4-828fb90638f2",
+			"account_name": "Bank Name",
+			"payee_id": "0d0e928d-312a-4bcd-89c4-e02f40d1fe46",
+			"payee_name": "bla bla bla",
+			"category_id": "f3cc4f55-312a-4bcd-89c4-db34379cb1dc",
+			"category_name": "Yearly subscription",
+			"transfer_account_id": null,
+			"deleted": false,
+			"subtransactions": []
+    }
+	}
+}
+		`)
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	stx, err := client.Transaction().GetScheduledTransaction(
+		"aa248caa-eed7-4575-a990-717386438d2c",
+		"56f4fc86-2ed7-4b3b-9116-7a214261b3cd",
+	)
+	assert.NoError(t, err)
+
+	expectedFirstAndLastDate, err := api.DateFromString("2018-11-13")
+	assert.NoError(t, err)
+
+	expectedMemo := "nice memo"
+	expectedFlagColor := transaction.FlagColorYellow
+	expectedPayeeID := "0d0e928d-312a-4bcd-89c4-e02f40d1fe46"
+	expectedPayeeName := "bla bla bla"
+	expectedCategoryID := "f3cc4f55-312a-4bcd-89c4-db34379cb1dc"
+	expectedCategoryName := "Yearly subscription"
+
+	expected := &transaction.Scheduled{
+		ID:              "56f4fc86-2ed7-4b3b-9116-7a214261b3cd",
+		DateFirst:       expectedFirstAndLastDate,
+		DateNext:        expectedFirstAndLastDate,
+		Frequency:       transaction.FrequencyNever,
+		Amount:          int64(-9000),
+		Memo:            &expectedMemo,
+		FlagColor:       &expectedFlagColor,
+		AccountID:       "09eaca5e-312a-4bcd-89c4-828fb90638f2",
+		AccountName:     "Bank Name",
+		PayeeID:         &expectedPayeeID,
+		PayeeName:       &expectedPayeeName,
+		CategoryID:      &expectedCategoryID,
+		CategoryName:    &expectedCategoryName,
+		Deleted:         false,
+		SubTransactions: []*transaction.ScheduledSubTransaction{},
+	}
+	assert.Equal(t, expected, stx)
+}
+
+func TestService_CreateScheduledTransaction(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	payloadDate, err := api.DateFromString("2018-12-13")
+	assert.NoError(t, err)
+
+	payloadPayeeID := "0d0e928d-312a-4bcd-89c4-e02f40d1fe46"
+	payloadCategoryID := "f3cc4f55-312a-4bcd-89c4-db34379cb1dc"
+	payloadMemo := "nice memo"
+	payloadFlagColor := transaction.FlagColorYellow
+
+	payload := transaction.PayloadScheduledTransaction{
+		AccountID:  "09eaca5e-312a-4bcd-89c4-828fb90638f2",
+		DateFirst:  payloadDate,
+		Frequency:  transaction.FrequencyMonthly,
+		Amount:     int64(-9000),
+		PayeeID:    &payloadPayeeID,
+		CategoryID: &payloadCategoryID,
+		Memo:       &payloadMemo,
+		FlagColor:  &payloadFlagColor,
+	}
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/scheduled_transactions"
+	httpmock.RegisterResponder(http.MethodPost, url,
+		func(req *http.Request) (*http.Response, error) {
+			reqModel := struct {
+				ScheduledTransaction map[string]interface{} `json:"scheduled_transaction"`
+			}{}
+			err := json.NewDecoder(req.Body).Decode(&reqModel)
+			assert.NoError(t, err)
+			assert.Equal(t, "2018-12-13", reqModel.ScheduledTransaction["date"])
+			assert.Equal(t, "monthly", reqModel.ScheduledTransaction["frequency"])
+			assert.Equal(t, "yellow", reqModel.ScheduledTransaction["flag_color"])
+			assert.NotContains(t, reqModel.ScheduledTransaction, "subtransactions")
+
+			res := httpmock.NewStringResponse(201, `{
+  "data": {
+    "scheduled_transaction": {
+			"id": "56f4fc86-2ed7-4b3b-9116-7a214261b3cd",
+			"date_first": "2018-12-13",
+			"date_next": "2018-12-13",
+			"frequency": "monthly",
+			"amount": -9000,
+			"memo": "nice memo",
+			"flag_color": "yellow",
+			"account_id": "09eaca5e-312a-4bcd-89c4-828fb90638f2",
+			"account_name": "Bank Name",
+			"payee_id": "0d0e928d-312a-4bcd-89c4-e02f40d1fe46",
+			"payee_name": "bla bla bla",
+			"category_id": "f3cc4f55-312a-4bcd-89c4-db34379cb1dc",
+			"category_name": "Yearly subscription",
+			"transfer_account_id": null,
+			"deleted": false,
+			"subtransactions": []
+    }
+	}
+}
+		`)
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	stx, err := client.Transaction().CreateScheduledTransaction(
+		"aa248caa-eed7-4575-a990-717386438d2c", payload)
+	assert.NoError(t, err)
+
+	expectedPayeeName := "bla bla bla"
+	expectedCategoryName := "Yearly subscription"
+
+	expected := &transaction.Scheduled{
+		ID:              "56f4fc86-2ed7-4b3b-9116-7a214261b3cd",
+		DateFirst:       payloadDate,
+		DateNext:        payloadDate,
+		Frequency:       transaction.FrequencyMonthly,
+		Amount:          int64(-9000),
+		Memo:            &payloadMemo,
+		FlagColor:       &payloadFlagColor,
+		AccountID:       "09eaca5e-312a-4bcd-89c4-828fb90638f2",
+		AccountName:     "Bank Name",
+		PayeeID:         &payloadPayeeID,
+		PayeeName:       &expectedPayeeName,
+		CategoryID:      &payloadCategoryID,
+		CategoryName:    &expectedCategoryName,
+		Deleted:         false,
+		SubTransactions: []*transaction.ScheduledSubTransaction{},
+	}
+	assert.Equal(t, expected, stx)
+}
+
+func TestService_UpdateScheduledTransaction(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	payloadDate, err := api.DateFromString("2018-12-13")
+	assert.NoError(t, err)
+
+	payloadCategoryID := "f3cc4f55-312a-4bcd-89c4-db34379cb1dc"
+	payloadOtherCategoryID := "7c9b4d1e-56af-4b6f-9c37-2b7c6a5c1a0e"
+
+	payload := transaction.PayloadScheduledTransaction{
+		AccountID: "09eaca5e-312a-4bcd-89c4-828fb90638f2",
+		DateFirst: payloadDate,
+		Frequency: transaction.FrequencyYearly,
+		Amount:    int64(-9000),
+		SubTransactions: []transaction.PayloadScheduledSubTransaction{
+			{Amount: int64(-6000), CategoryID: &payloadCategoryID},
+			{Amount: int64(-3000), CategoryID: &payloadOtherCategoryID},
+		},
+	}
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/scheduled_transactions/56f4fc86-2ed7-4b3b-9116-7a214261b3cd"
+	httpmock.RegisterResponder(http.MethodPut, url,
+		func(req *http.Request) (*http.Response, error) {
+			reqModel := struct {
+				ScheduledTransaction *transaction.PayloadScheduledTransaction `json:"scheduled_transaction"`
+			}{}
+			err := json.NewDecoder(req.Body).Decode(&reqModel)
+			assert.NoError(t, err)
+			assert.Equal(t, &payload, reqModel.ScheduledTransaction)
+
+			res := httpmock.NewStringResponse(200, `{
+  "data": {
+    "scheduled_transaction": {
+			"id": "56f4fc86-2ed7-4b3b-9116-7a214261b3cd",
+			"date_first": "2018-12-13",
+			"date_next": "2018-12-13",
+			"frequency": "yearly",
+			"amount": -9000,
+			"account_id": "09eaca5e-312a-4bcd-89c4-828fb90638f2",
+			"account_name": "Bank Name",
+			"deleted": false,
+			"subtransactions": []
+    }
+	}
+}
+		`)
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	stx, err := client.Transaction().UpdateScheduledTransaction(
+		"aa248caa-eed7-4575-a990-717386438d2c",
+		"56f4fc86-2ed7-4b3b-9116-7a214261b3cd",
+		payload,
+	)
+	assert.NoError(t, err)
+
+	expected := &transaction.Scheduled{
+		ID:              "56f4fc86-2ed7-4b3b-9116-7a214261b3cd",
+		DateFirst:       payloadDate,
+		DateNext:        payloadDate,
+		Frequency:       transaction.FrequencyYearly,
+		Amount:          int64(-9000),
+		AccountID:       "09eaca5e-312a-4bcd-89c4-828fb90638f2",
+		AccountName:     "Bank Name",
+		Deleted:         false,
+		SubTransactions: []*transaction.ScheduledSubTransaction{},
+	}
+	assert.Equal(t, expected, stx)
+}
+
+func TestService_DeleteScheduledTransaction(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/scheduled_transactions/56f4fc86-2ed7-4b3b-9116-7a214261b3cd"
+	httpmock.RegisterResponder(http.MethodDelete, url,
+		func(req *http.Request) (*http.Response, error) {
+			res := httpmock.NewStringResponse(200, `{
+  "data": {
+    "scheduled_transaction": {
+			"id": "56f4fc86-2ed7-4b3b-9116-7a214261b3cd",
+			"deleted": true
+		}
+	}
+}
+		`)
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	stx, err := client.Transaction().DeleteScheduledTransaction(
+		"aa248caa-eed7-4575-a990-717386438d2c",
+		"56f4fc86-2ed7-4b3b-9116-7a214261b3cd",
+	)
+	assert.NoError(t, err)
+
+	expected := &transaction.Scheduled{
+		ID:      "56f4fc86-2ed7-4b3b-9116-7a214261b3cd",
+		Deleted: true,
+	}
+	assert.Equal(t, expected, stx)
+}
+
+func TestService_CreateTransaction(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	payloadDate, err := api.DateFromString("2018-11-13")
+	assert.NoError(t, err)
+
+	payloadPayeeID := "0d0e928d-312a-4bcd-89c4-e02f40d1fe46"
+	payloadPayeeName := "bla bla bla"
+	payloadCategoryID := "f3cc4f55-312a-4bcd-89c4-db34379cb1dc"
+	payloadMemo := "nice memo"
+	payloadFlagColor := transaction.FlagColorBlue
+
+	payload := transaction.PayloadTransaction{
+		AccountID:  "09eaca5e-312a-4bcd-89c4-828fb90638f2",
+		Date:       payloadDate,
+		Amount:     int64(-9000),
+		Cleared:    transaction.ClearingStatusCleared,
+		Approved:   true,
+		PayeeID:    &payloadPayeeID,
+		PayeeName:  &payloadPayeeName,
+		CategoryID: &payloadCategoryID,
+		Memo:       &payloadMemo,
+		FlagColor:  &payloadFlagColor,
+	}
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/transactions"
+	httpmock.RegisterResponder(http.MethodPost, url,
+		func(req *http.Request) (*http.Response, error) {
+			resModel := struct {
+				Data *transaction.OperationSummary `json:"data"`
+			}{}
+			err := json.NewDecoder(req.Body).Decode(&resModel)
+			assert.NoError(t, err)
+
+			res := httpmock.NewStringResponse(200, `{
+  "data": {
+		"transaction_ids": ["0f5b3f73-ded2-4dd7-8b01-c23022622cd6"],
+		"duplicate_import_ids": [],
+    "transaction": {
+			"id": "0f5b3f73-ded2-4dd7-8b01-c23022622cd6",
+      "date": "2018-11-13",
+      "amount": -9000,
+      "memo": "nice memo",
+      "cleared": "cleared",
+      "approved": true,
+      "flag_color": "blue",
+      "account_id": "09eaca5e-312a-4bcd-89c4-828fb90638f2",
+      "account_name": "Bank Name",
+      "payee_id": "0d0e928d-312a-4bcd-89c4-e02f40d1fe46",
+      "payee_name": "bla bla bla",
+      "category_id": "f3cc4f55-312a-4bcd-89c4-db34379cb1dc",
+      "category_name": "Groceries",
+      "transfer_account_id": null,
+      "import_id": null,
+      "deleted": false,
+      "subtransactions": []
+		}
+  }
+}
+		`)
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	tx, err := client.Transaction().CreateTransaction("aa248caa-eed7-4575-a990-717386438d2c", payload)
+	assert.NoError(t, err)
+
+	expectedCategoryName := "Groceries"
+	expectedTransactions := &transaction.OperationSummary{
+		TransactionIDs: []string{
+			"0f5b3f73-ded2-4dd7-8b01-c23022622cd6",
+		},
+		DuplicateImportIDs: []string{},
+		Transaction: &transaction.Transaction{
 			ID:              "0f5b3f73-ded2-4dd7-8b01-c23022622cd6",
 			Date:            payload.Date,
 			Amount:          payload.Amount,
@@ -701,6 +1326,81 @@ func TestService_CreateTransaction(t *testing.T) {
 	assert.Equal(t, expectedTransactions, tx)
 }
 
+func TestService_CreateTransaction_split(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	payloadDate, err := api.DateFromString("2018-11-13")
+	assert.NoError(t, err)
+
+	groceriesCategoryID := "f3cc4f55-312a-4bcd-89c4-db34379cb1dc"
+	householdCategoryID := "7c9b4d1e-56af-4b6f-9c37-2b7c6a5c1a0e"
+	householdMemo := "soap"
+
+	payload := transaction.PayloadTransaction{
+		AccountID: "09eaca5e-312a-4bcd-89c4-828fb90638f2",
+		Date:      payloadDate,
+		Amount:    int64(-9000),
+		Cleared:   transaction.ClearingStatusCleared,
+		SubTransactions: []transaction.PayloadSubTransaction{
+			{Amount: int64(-7000), CategoryID: &groceriesCategoryID},
+			{Amount: int64(-2000), CategoryID: &householdCategoryID, Memo: &householdMemo},
+		},
+	}
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/transactions"
+	httpmock.RegisterResponder(http.MethodPost, url,
+		func(req *http.Request) (*http.Response, error) {
+			reqModel := struct {
+				Transactions []transaction.PayloadTransaction `json:"transactions"`
+			}{}
+			err := json.NewDecoder(req.Body).Decode(&reqModel)
+			assert.NoError(t, err)
+			assert.Equal(t, []transaction.PayloadTransaction{payload}, reqModel.Transactions)
+
+			res := httpmock.NewStringResponse(200, `{
+  "data": {
+		"transaction_ids": ["0f5b3f73-ded2-4dd7-8b01-c23022622cd6"],
+		"duplicate_import_ids": []
+  }
+}
+		`)
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	tx, err := client.Transaction().CreateTransaction("aa248caa-eed7-4575-a990-717386438d2c", payload)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"0f5b3f73-ded2-4dd7-8b01-c23022622cd6"}, tx.TransactionIDs)
+}
+
+func TestService_CreateTransaction_splitAmountMismatch(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	payload := transaction.PayloadTransaction{
+		AccountID: "09eaca5e-312a-4bcd-89c4-828fb90638f2",
+		Amount:    int64(-9000),
+		SubTransactions: []transaction.PayloadSubTransaction{
+			{Amount: int64(-7000)},
+			{Amount: int64(-1000)},
+		},
+	}
+
+	client := ynab.NewClient("")
+	tx, err := client.Transaction().CreateTransaction("aa248caa-eed7-4575-a990-717386438d2c", payload)
+	assert.Nil(t, tx)
+	assert.Equal(t, transaction.ErrSubTransactionsAmountMismatch, err)
+
+	updated, err := client.Transaction().UpdateTransaction("aa248caa-eed7-4575-a990-717386438d2c",
+		"0f5b3f73-ded2-4dd7-8b01-c23022622cd6", payload)
+	assert.Nil(t, updated)
+	assert.Equal(t, transaction.ErrSubTransactionsAmountMismatch, err)
+
+	assert.Zero(t, httpmock.GetTotalCallCount())
+}
+
 func TestService_CreateTransactions(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
@@ -1064,7 +1764,46 @@ func TestService_BulkCreateTransactions(t *testing.T) {
   "data": {
     "bulk": {
       "transaction_ids": ["aaaaa321-eed7-4575-a990-717386438d2c"],
-      "duplicate_import_ids": ["asdfg"]
+      "duplicate_import_ids": ["asdfg"]
+    }
+	}
+}
+		`)
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	bulk, err := client.Transaction().BulkCreateTransactions(
+		"aa248caa-eed7-4575-a990-717386438d2c",
+		payload,
+	)
+	assert.NoError(t, err)
+
+	expectedBunk := &transaction.Bulk{
+		TransactionIDs:     []string{"aaaaa321-eed7-4575-a990-717386438d2c"},
+		DuplicateImportIDs: []string{"asdfg"},
+	}
+
+	assert.Equal(t, expectedBunk, bulk)
+}
+
+func TestService_BulkCreateTransactionsWithContext(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "value")
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/transactions/bulk"
+	httpmock.RegisterResponder(http.MethodPost, url,
+		func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "value", req.Context().Value(ctxKey{}))
+			res := httpmock.NewStringResponse(200, `{
+  "data": {
+    "bulk": {
+      "transaction_ids": ["aaaaa321-eed7-4575-a990-717386438d2c"],
+      "duplicate_import_ids": []
     }
 	}
 }
@@ -1074,18 +1813,22 @@ func TestService_BulkCreateTransactions(t *testing.T) {
 	)
 
 	client := ynab.NewClient("")
-	bulk, err := client.Transaction().BulkCreateTransactions(
+	bulk, err := client.Transaction().BulkCreateTransactionsWithContext(ctx,
 		"aa248caa-eed7-4575-a990-717386438d2c",
-		payload,
+		[]transaction.PayloadTransaction{
+			{
+				AccountID: "09eaca5e-312a-4bcd-89c4-828fb90638f2",
+				Amount:    int64(-9000),
+			},
+		},
 	)
 	assert.NoError(t, err)
 
-	expectedBunk := &transaction.Bulk{
+	expected := &transaction.Bulk{
 		TransactionIDs:     []string{"aaaaa321-eed7-4575-a990-717386438d2c"},
-		DuplicateImportIDs: []string{"asdfg"},
+		DuplicateImportIDs: []string{},
 	}
-
-	assert.Equal(t, expectedBunk, bulk)
+	assert.Equal(t, expected, bulk)
 }
 
 func TestService_UpdateTransaction(t *testing.T) {
@@ -1211,6 +1954,76 @@ func TestService_DeleteTransaction(t *testing.T) {
 	assert.Equal(t, expected, tx)
 }
 
+func TestService_DeleteTransactionWithContext(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "value")
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/transactions/e6ad88f5-6f16-4480-9515-5377012750dd"
+	httpmock.RegisterResponder(http.MethodDelete, url,
+		func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "value", req.Context().Value(ctxKey{}))
+			res := httpmock.NewStringResponse(200, `{
+  "data": {
+    "transaction": {
+			"id": "e6ad88f5-6f16-4480-9515-5377012750dd"
+		}
+	}
+}
+		`)
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	tx, err := client.Transaction().DeleteTransactionWithContext(ctx,
+		"aa248caa-eed7-4575-a990-717386438d2c",
+		"e6ad88f5-6f16-4480-9515-5377012750dd",
+	)
+	assert.NoError(t, err)
+
+	expected := &transaction.Transaction{
+		ID: "e6ad88f5-6f16-4480-9515-5377012750dd",
+	}
+	assert.Equal(t, expected, tx)
+}
+
+func TestService_CreateTransactionsWithContext_idempotency(t *testing.T) {
+	importID := "YNAB:-9000:2018-11-13:1"
+
+	table := []struct {
+		Name       string
+		Payload    []transaction.PayloadTransaction
+		Idempotent bool
+	}{
+		{"all with import id", []transaction.PayloadTransaction{{ImportID: &importID}, {ImportID: &importID}}, true},
+		{"some without import id", []transaction.PayloadTransaction{{ImportID: &importID}, {}}, false},
+		{"without import id", []transaction.PayloadTransaction{{}}, false},
+	}
+
+	for _, test := range table {
+		t.Run(test.Name, func(t *testing.T) {
+			httpmock.Activate()
+			defer httpmock.DeactivateAndReset()
+
+			url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/transactions"
+			httpmock.RegisterResponder(http.MethodPost, url,
+				func(req *http.Request) (*http.Response, error) {
+					assert.Equal(t, test.Idempotent, api.IsIdempotent(req.Context()))
+					return httpmock.NewStringResponse(200, `{"data": {}}`), nil
+				},
+			)
+
+			client := ynab.NewClient("")
+			_, err := client.Transaction().CreateTransactionsWithContext(context.Background(),
+				"aa248caa-eed7-4575-a990-717386438d2c", test.Payload)
+			assert.NoError(t, err)
+		})
+	}
+}
+
 func TestFilter_ToQuery(t *testing.T) {
 	sinceDate, err := api.DateFromString("2020-02-02")
 	assert.NoError(t, err)
@@ -1244,6 +2057,14 @@ func TestFilter_ToQuery(t *testing.T) {
 			Input:  transaction.Filter{Since: &zeroDate, Type: &uncategorizedTransaction},
 			Output: "type=uncategorized",
 		},
+		{
+			Input:  transaction.Filter{Type: &unapprovedTransaction, LastKnowledgeOfServer: 10},
+			Output: "type=unapproved&last_knowledge_of_server=10",
+		},
+		{
+			Input:  transaction.Filter{LastKnowledgeOfServer: 10},
+			Output: "last_knowledge_of_server=10",
+		},
 		{
 			Input:  transaction.Filter{},
 			Output: "",
@@ -1254,3 +2075,316 @@ func TestFilter_ToQuery(t *testing.T) {
 		assert.Equal(t, test.Output, test.Input.ToQuery())
 	}
 }
+
+func TestService_PatchTransaction(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/transactions/e6ad88f5-6f16-4480-9515-5377012750dd"
+	httpmock.RegisterResponder(http.MethodPut, url,
+		func(req *http.Request) (*http.Response, error) {
+			reqModel := struct {
+				Transaction map[string]interface{} `json:"transaction"`
+			}{}
+			err := json.NewDecoder(req.Body).Decode(&reqModel)
+			assert.NoError(t, err)
+			assert.Equal(t, map[string]interface{}{
+				"memo":       "new memo",
+				"flag_color": nil,
+			}, reqModel.Transaction)
+
+			res := httpmock.NewStringResponse(200, `{
+  "data": {
+    "transaction": {
+      "id": "e6ad88f5-6f16-4480-9515-5377012750dd",
+      "memo": "new memo"
+    }
+  }
+}
+		`)
+			return res, nil
+		},
+	)
+
+	memo := "new memo"
+	p := transaction.PayloadTransactionPatch{ID: "e6ad88f5-6f16-4480-9515-5377012750dd"}
+	p.SetMemo(&memo).SetFlagColor(nil)
+
+	client := ynab.NewClient("")
+	tx, err := client.Transaction().PatchTransaction(
+		"aa248caa-eed7-4575-a990-717386438d2c",
+		"e6ad88f5-6f16-4480-9515-5377012750dd",
+		p,
+	)
+	assert.NoError(t, err)
+
+	expected := &transaction.Transaction{
+		ID:   "e6ad88f5-6f16-4480-9515-5377012750dd",
+		Memo: &memo,
+	}
+	assert.Equal(t, expected, tx)
+}
+
+func TestService_PatchTransactions(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/transactions"
+	httpmock.RegisterResponder(http.MethodPatch, url,
+		func(req *http.Request) (*http.Response, error) {
+			reqModel := struct {
+				Transactions []map[string]interface{} `json:"transactions"`
+			}{}
+			err := json.NewDecoder(req.Body).Decode(&reqModel)
+			assert.NoError(t, err)
+			assert.Equal(t, []map[string]interface{}{
+				{
+					"id":       "e6ad88f5-6f16-4480-9515-5377012750dd",
+					"date":     "2018-03-10",
+					"amount":   float64(-43950),
+					"approved": true,
+				},
+				{
+					"id":          "9453526b-2f58-4c02-9683-a30c2a1192d7",
+					"category_id": nil,
+					"cleared":     "cleared",
+				},
+			}, reqModel.Transactions)
+
+			res := httpmock.NewStringResponse(200, `{
+  "data": {
+    "transaction_ids": [
+      "e6ad88f5-6f16-4480-9515-5377012750dd",
+      "9453526b-2f58-4c02-9683-a30c2a1192d7"
+    ],
+    "server_knowledge": 10
+  }
+}
+		`)
+			return res, nil
+		},
+	)
+
+	date, err := api.DateFromString("2018-03-10")
+	assert.NoError(t, err)
+
+	first := transaction.PayloadTransactionPatch{ID: "e6ad88f5-6f16-4480-9515-5377012750dd"}
+	first.SetDate(date).SetAmount(-43950).SetApproved(true)
+	second := transaction.PayloadTransactionPatch{ID: "9453526b-2f58-4c02-9683-a30c2a1192d7"}
+	second.SetCategoryID(nil).SetCleared(transaction.ClearingStatusCleared)
+
+	client := ynab.NewClient("")
+	summary, err := client.Transaction().PatchTransactions(
+		"aa248caa-eed7-4575-a990-717386438d2c",
+		[]transaction.PayloadTransactionPatch{first, second},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"e6ad88f5-6f16-4480-9515-5377012750dd",
+		"9453526b-2f58-4c02-9683-a30c2a1192d7",
+	}, summary.TransactionIDs)
+}
+
+func TestService_GetTransactionsByAccountSnapshot(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/accounts/09eaca5e-6f16-4480-9515-828fb90638f2/transactions?last_knowledge_of_server=10"
+	httpmock.RegisterResponder(http.MethodGet, url,
+		func(req *http.Request) (*http.Response, error) {
+			res := httpmock.NewStringResponse(200, `{
+  "data": {
+    "transactions": [
+      {
+        "id": "e6ad88f5-6f16-4480-9515-5377012750dd",
+        "deleted": true
+      }
+    ],
+    "server_knowledge": 12
+  }
+}
+		`)
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	snapshot, err := client.Transaction().GetTransactionsByAccountSnapshot(
+		"aa248caa-eed7-4575-a990-717386438d2c",
+		"09eaca5e-6f16-4480-9515-828fb90638f2",
+		&transaction.Filter{LastKnowledgeOfServer: 10},
+	)
+	assert.NoError(t, err)
+
+	expected := &transaction.SearchResultSnapshot{
+		Transactions: []*transaction.Transaction{
+			{
+				ID:      "e6ad88f5-6f16-4480-9515-5377012750dd",
+				Deleted: true,
+			},
+		},
+		ServerKnowledge: uint64(12),
+	}
+	assert.Equal(t, expected, snapshot)
+}
+
+func TestService_GetTransactionsByCategorySnapshot(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/categories/e9517027-6f16-4480-9515-5981bed2e9e1/transactions?last_knowledge_of_server=10"
+	httpmock.RegisterResponder(http.MethodGet, url,
+		func(req *http.Request) (*http.Response, error) {
+			res := httpmock.NewStringResponse(200, `{
+  "data": {
+    "transactions": [
+      {
+        "id": "e6ad88f5-6f16-4480-9515-5377012750dd",
+        "type": "transaction",
+        "deleted": true
+      }
+    ],
+    "server_knowledge": 12
+  }
+}
+		`)
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	snapshot, err := client.Transaction().GetTransactionsByCategorySnapshot(
+		"aa248caa-eed7-4575-a990-717386438d2c",
+		"e9517027-6f16-4480-9515-5981bed2e9e1",
+		&transaction.Filter{LastKnowledgeOfServer: 10},
+	)
+	assert.NoError(t, err)
+
+	assert.Equal(t, uint64(12), snapshot.ServerKnowledge)
+	if assert.Len(t, snapshot.Transactions, 1) {
+		assert.Equal(t, "e6ad88f5-6f16-4480-9515-5377012750dd", snapshot.Transactions[0].ID)
+		assert.True(t, snapshot.Transactions[0].Deleted)
+	}
+}
+
+func TestService_GetTransactionsByPayeeSnapshot(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/payees/6216ab4b-6f16-4480-9515-be2dee26ab0d/transactions?last_knowledge_of_server=10"
+	httpmock.RegisterResponder(http.MethodGet, url,
+		func(req *http.Request) (*http.Response, error) {
+			res := httpmock.NewStringResponse(200, `{
+  "data": {
+    "transactions": [
+      {
+        "id": "e6ad88f5-6f16-4480-9515-5377012750dd",
+        "type": "transaction",
+        "deleted": false
+      }
+    ],
+    "server_knowledge": 12
+  }
+}
+		`)
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	snapshot, err := client.Transaction().GetTransactionsByPayeeSnapshot(
+		"aa248caa-eed7-4575-a990-717386438d2c",
+		"6216ab4b-6f16-4480-9515-be2dee26ab0d",
+		&transaction.Filter{LastKnowledgeOfServer: 10},
+	)
+	assert.NoError(t, err)
+
+	assert.Equal(t, uint64(12), snapshot.ServerKnowledge)
+	if assert.Len(t, snapshot.Transactions, 1) {
+		assert.Equal(t, "e6ad88f5-6f16-4480-9515-5377012750dd", snapshot.Transactions[0].ID)
+	}
+}
+
+func TestService_GetTransactionsByMonthSnapshot(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/months/2018-11-01/transactions?last_knowledge_of_server=10"
+	httpmock.RegisterResponder(http.MethodGet, url,
+		func(req *http.Request) (*http.Response, error) {
+			res := httpmock.NewStringResponse(200, `{
+  "data": {
+    "transactions": [
+      {
+        "id": "e6ad88f5-6f16-4480-9515-5377012750dd",
+        "deleted": true
+      }
+    ],
+    "server_knowledge": 12
+  }
+}
+		`)
+			return res, nil
+		},
+	)
+
+	month, err := api.DateFromString("2018-11-01")
+	assert.NoError(t, err)
+
+	client := ynab.NewClient("")
+	snapshot, err := client.Transaction().GetTransactionsByMonthSnapshot(
+		"aa248caa-eed7-4575-a990-717386438d2c",
+		month,
+		&transaction.Filter{LastKnowledgeOfServer: 10},
+	)
+	assert.NoError(t, err)
+
+	expected := &transaction.SearchResultSnapshot{
+		Transactions: []*transaction.Transaction{
+			{
+				ID:      "e6ad88f5-6f16-4480-9515-5377012750dd",
+				Deleted: true,
+			},
+		},
+		ServerKnowledge: uint64(12),
+	}
+	assert.Equal(t, expected, snapshot)
+}
+
+func TestService_PatchTransactions_withoutID(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	first := transaction.PayloadTransactionPatch{ID: "e6ad88f5-6f16-4480-9515-5377012750dd"}
+	first.SetApproved(true)
+	second := transaction.PayloadTransactionPatch{}
+	second.SetApproved(true)
+
+	client := ynab.NewClient("")
+	summary, err := client.Transaction().PatchTransactions(
+		"aa248caa-eed7-4575-a990-717386438d2c",
+		[]transaction.PayloadTransactionPatch{first, second},
+	)
+	assert.Nil(t, summary)
+	assert.True(t, errors.Is(err, transaction.ErrPatchWithoutID))
+	assert.EqualError(t, err, "transaction: patch without ID: index 1")
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
+
+func TestPayloadTransactionPatch_copy(t *testing.T) {
+	base := transaction.PayloadTransactionPatch{}
+	base.SetApproved(true)
+
+	memo := "copied"
+	copied := base
+	copied.ID = "e6ad88f5-6f16-4480-9515-5377012750dd"
+	copied.SetMemo(&memo)
+
+	buf, err := json.Marshal(base)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"approved":true}`, string(buf))
+
+	buf, err = json.Marshal(copied)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"approved":true,"id":"e6ad88f5-6f16-4480-9515-5377012750dd","memo":"copied"}`, string(buf))
+}
@@ -5,8 +5,10 @@
 package user_test
 
 import (
+	"context"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/brunomvsouza/ynab.go"
 )
@@ -18,3 +20,14 @@ func ExampleService_GetUser() {
 
 	// Output: *user.User
 }
+
+func ExampleService_GetUserWithContext() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	c := ynab.NewClient("<valid_ynab_access_token>")
+	user, _ := c.User().GetUserWithContext(ctx)
+	fmt.Println(reflect.TypeOf(user))
+
+	// Output: *user.User
+}
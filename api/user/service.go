@@ -5,6 +5,8 @@
 package user
 
 import (
+	"context"
+
 	"github.com/brunomvsouza/ynab.go/api"
 )
 
@@ -21,13 +23,20 @@ type Service struct {
 // GetUser fetches information about the authenticated user
 // https://api.youneedabudget.com/v1#/User/getUser
 func (s *Service) GetUser() (*User, error) {
+	return s.GetUserWithContext(context.Background())
+}
+
+// GetUserWithContext fetches information about the authenticated user
+// bound to ctx
+// https://api.youneedabudget.com/v1#/User/getUser
+func (s *Service) GetUserWithContext(ctx context.Context) (*User, error) {
 	resModel := struct {
 		Data struct {
 			User *User `json:"user"`
 		} `json:"data"`
 	}{}
 
-	if err := s.c.GET("/user", &resModel); err != nil {
+	if err := s.c.GETWithContext(ctx, "/user", &resModel); err != nil {
 		return nil, err
 	}
 	return resModel.Data.User, nil
@@ -5,6 +5,7 @@
 package user_test
 
 import (
+	"context"
 	"net/http"
 	"testing"
 
@@ -43,3 +44,35 @@ func TestService_GetUser(t *testing.T) {
 	assert.Equal(t, expected, u)
 
 }
+
+func TestService_GetUserWithContext(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "value")
+
+	httpmock.RegisterResponder(http.MethodGet, "https://api.youneedabudget.com/v1/user",
+		func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "value", req.Context().Value(ctxKey{}))
+			res := httpmock.NewStringResponse(200, `{
+  "data": {
+    "user": {
+      "id": "aa248caa-eed7-4575-a990-717386438d2c"
+    }
+  }
+}
+		`)
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	u, err := client.User().GetUserWithContext(ctx)
+	assert.NoError(t, err)
+
+	expected := &user.User{
+		ID: "aa248caa-eed7-4575-a990-717386438d2c",
+	}
+	assert.Equal(t, expected, u)
+}
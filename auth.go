@@ -0,0 +1,54 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package ynab
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/brunomvsouza/ynab.go/oauth"
+)
+
+// WithTokenSource authenticates requests with the OAuth tokens supplied
+// by ts instead of the access token given to NewClient. When ts
+// implements oauth.Refresher, a request rejected with 401 Unauthorized is
+// sent once more after refreshing the token.
+func WithTokenSource(ts oauth.TokenSource) Option {
+	return func(c *client) {
+		c.tokenSource = ts
+	}
+}
+
+// authorize sets the Authorization header of req
+func (c *client) authorize(ctx context.Context, req *Request) error {
+	if c.tokenSource == nil {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.accessToken))
+		return nil
+	}
+
+	t, err := c.tokenSource.Token(ctx)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", t.AccessToken))
+	return nil
+}
+
+// refreshAuthorization refreshes the token of the client's token source
+// and updates the Authorization header of req. It returns false when
+// the token source cannot be refreshed.
+func (c *client) refreshAuthorization(ctx context.Context, req *Request) (bool, error) {
+	r, ok := c.tokenSource.(oauth.Refresher)
+	if !ok {
+		return false, nil
+	}
+
+	t, err := r.Refresh(ctx)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", t.AccessToken))
+	return true, nil
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package ynab
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/brunomvsouza/ynab.go/api"
+	"github.com/brunomvsouza/ynab.go/oauth"
+)
+
+func TestWithTokenSource(t *testing.T) {
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "refresh_token", r.PostForm.Get("grant_type"))
+		w.Write([]byte(`{"access_token":"new-token","token_type":"bearer","expires_in":7200,"refresh_token":"r2"}`)) //nolint:errcheck
+	}))
+	defer tokenServer.Close()
+
+	t.Run("refreshes the token once on 401", func(t *testing.T) {
+		var authorizations []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authorizations = append(authorizations, r.Header.Get("Authorization"))
+			if r.Header.Get("Authorization") != "Bearer new-token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				w.Write([]byte(`{"error":{"id":"401","name":"unauthorized","detail":"Unauthorized"}}`)) //nolint:errcheck
+				return
+			}
+			w.Write([]byte(`{"foo":"bar"}`)) //nolint:errcheck
+		}))
+		defer server.Close()
+
+		config := &oauth.Config{TokenURL: tokenServer.URL}
+		ts := config.TokenSource(&oauth.Token{
+			AccessToken:  "revoked-token",
+			RefreshToken: "r1",
+			Expiry:       time.Now().Add(time.Hour),
+		}, nil)
+
+		response := struct {
+			Foo string `json:"foo"`
+		}{}
+
+		c := NewClient("ignored", WithBaseURL(server.URL), WithTokenSource(ts))
+		err := c.(*client).GET("/foo", &response)
+		assert.NoError(t, err)
+		assert.Equal(t, "bar", response.Foo)
+		assert.Equal(t, []string{"Bearer revoked-token", "Bearer new-token"}, authorizations)
+	})
+
+	t.Run("static token source is not refreshed", func(t *testing.T) {
+		requests := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			assert.Equal(t, "Bearer static-token", r.Header.Get("Authorization"))
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+		defer server.Close()
+
+		ts := oauth.StaticTokenSource(&oauth.Token{AccessToken: "static-token"})
+
+		c := NewClient("", WithBaseURL(server.URL), WithTokenSource(ts))
+		err := c.(*client).GET("/foo", &struct{}{})
+		assert.True(t, errors.Is(err, api.ErrUnauthorized))
+		assert.Equal(t, 1, requests)
+	})
+}
@@ -7,21 +7,25 @@ package ynab // import "github.com/brunomvsouza/ynab.go"
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/brunomvsouza/ynab.go/api"
 	"github.com/brunomvsouza/ynab.go/api/account"
 	"github.com/brunomvsouza/ynab.go/api/budget"
 	"github.com/brunomvsouza/ynab.go/api/category"
+	"github.com/brunomvsouza/ynab.go/api/moneymovement"
 	"github.com/brunomvsouza/ynab.go/api/month"
 	"github.com/brunomvsouza/ynab.go/api/payee"
 	"github.com/brunomvsouza/ynab.go/api/transaction"
 	"github.com/brunomvsouza/ynab.go/api/user"
+	"github.com/brunomvsouza/ynab.go/oauth"
 )
 
 const apiEndpoint = "https://api.youneedabudget.com/v1"
@@ -35,14 +39,25 @@ type ClientServicer interface {
 	Payee() *payee.Service
 	Month() *month.Service
 	Transaction() *transaction.Service
+	MoneyMovement() *moneymovement.Service
+
+	RateLimit() (used, limit int)
 }
 
 // NewClient facilitates the creation of a new client instance
-func NewClient(accessToken string) ClientServicer {
+func NewClient(accessToken string, opts ...Option) ClientServicer {
 	c := &client{
 		accessToken: accessToken,
 		client:      http.DefaultClient,
+		baseURL:     apiEndpoint,
+		header:      make(http.Header),
+		retryPolicy: DefaultRetryPolicy,
+	}
+
+	for _, opt := range opts {
+		opt(c)
 	}
+	c.handler = chain(c.roundTrip, c.middlewares)
 
 	c.user = user.NewService(c)
 	c.budget = budget.NewService(c)
@@ -51,6 +66,7 @@ func NewClient(accessToken string) ClientServicer {
 	c.payee = payee.NewService(c)
 	c.month = month.NewService(c)
 	c.transaction = transaction.NewService(c)
+	c.moneyMovement = moneymovement.NewService(c)
 	return c
 }
 
@@ -59,16 +75,29 @@ type client struct {
 	sync.Mutex
 
 	accessToken string
+	tokenSource oauth.TokenSource
+
+	client  *http.Client
+	baseURL string
+	header  http.Header
 
-	client    *http.Client
+	rateLimitPolicy  RateLimitPolicy
+	rateLimit        api.RateLimit
+	rateLimitResetAt time.Time
 
-	user        *user.Service
-	budget      *budget.Service
-	account     *account.Service
-	category    *category.Service
-	payee       *payee.Service
-	month       *month.Service
-	transaction *transaction.Service
+	retryPolicy RetryPolicy
+
+	middlewares []Middleware
+	handler     Handler
+
+	user          *user.Service
+	budget        *budget.Service
+	account       *account.Service
+	category      *category.Service
+	payee         *payee.Service
+	month         *month.Service
+	transaction   *transaction.Service
+	moneyMovement *moneymovement.Service
 }
 
 // User returns user.Service API instance
@@ -106,76 +135,185 @@ func (c *client) Transaction() *transaction.Service {
 	return c.transaction
 }
 
+// MoneyMovement returns moneymovement.Service API instance
+func (c *client) MoneyMovement() *moneymovement.Service {
+	return c.moneyMovement
+}
+
 // GET sends a GET request to the YNAB API
 func (c *client) GET(url string, responseModel interface{}) error {
-	return c.do(http.MethodGet, url, responseModel, nil)
+	return c.GETWithContext(context.Background(), url, responseModel)
 }
 
 // POST sends a POST request to the YNAB API
 func (c *client) POST(url string, responseModel interface{}, requestBody []byte) error {
-	return c.do(http.MethodPost, url, responseModel, requestBody)
+	return c.POSTWithContext(context.Background(), url, responseModel, requestBody)
 }
 
 // PUT sends a PUT request to the YNAB API
 func (c *client) PUT(url string, responseModel interface{}, requestBody []byte) error {
-	return c.do(http.MethodPut, url, responseModel, requestBody)
+	return c.PUTWithContext(context.Background(), url, responseModel, requestBody)
 }
 
 // PATCH sends a PATCH request to the YNAB API
 func (c *client) PATCH(url string, responseModel interface{}, requestBody []byte) error {
-	return c.do(http.MethodPatch, url, responseModel, requestBody)
+	return c.PATCHWithContext(context.Background(), url, responseModel, requestBody)
 }
 
 // DELETE sends a DELETE request to the YNAB API
 func (c *client) DELETE(url string, responseModel interface{}) error {
-	return c.do(http.MethodDelete, url, responseModel, nil)
+	return c.DELETEWithContext(context.Background(), url, responseModel)
+}
+
+// GETWithContext sends a GET request to the YNAB API bound to ctx
+func (c *client) GETWithContext(ctx context.Context, url string, responseModel interface{}) error {
+	return c.do(ctx, http.MethodGet, url, responseModel, nil)
+}
+
+// POSTWithContext sends a POST request to the YNAB API bound to ctx
+func (c *client) POSTWithContext(ctx context.Context, url string, responseModel interface{}, requestBody []byte) error {
+	return c.do(ctx, http.MethodPost, url, responseModel, requestBody)
+}
+
+// PUTWithContext sends a PUT request to the YNAB API bound to ctx
+func (c *client) PUTWithContext(ctx context.Context, url string, responseModel interface{}, requestBody []byte) error {
+	return c.do(ctx, http.MethodPut, url, responseModel, requestBody)
+}
+
+// PATCHWithContext sends a PATCH request to the YNAB API bound to ctx
+func (c *client) PATCHWithContext(ctx context.Context, url string, responseModel interface{}, requestBody []byte) error {
+	return c.do(ctx, http.MethodPatch, url, responseModel, requestBody)
+}
+
+// DELETEWithContext sends a DELETE request to the YNAB API bound to ctx
+func (c *client) DELETEWithContext(ctx context.Context, url string, responseModel interface{}) error {
+	return c.do(ctx, http.MethodDelete, url, responseModel, nil)
 }
 
 // do sends a request to the YNAB API
-func (c *client) do(method, url string, responseModel interface{}, requestBody []byte) error {
-	fullURL := fmt.Sprintf("%s%s", apiEndpoint, url)
-	req, err := http.NewRequest(method, fullURL, bytes.NewBuffer(requestBody))
-	if err != nil {
-		return err
+func (c *client) do(ctx context.Context, method, url string, responseModel interface{}, requestBody []byte) error {
+	req := &Request{
+		Method:        method,
+		URL:           fmt.Sprintf("%s%s", c.baseURL, url),
+		Header:        make(http.Header),
+		Body:          requestBody,
+		ResponseModel: responseModel,
 	}
 
+	for key, values := range c.header {
+		req.Header[key] = append([]string(nil), values...)
+	}
 	req.Header.Set("Accept", "application/json")
-	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", c.accessToken))
+	if err := c.authorize(ctx, req); err != nil {
+		return err
+	}
 	if method == http.MethodPost || method == http.MethodPut || method == http.MethodPatch {
 		req.Header.Set("Content-Type", "application/json")
 	}
 
-	res, err := c.client.Do(req)
+	return c.handler(ctx, req)
+}
+
+// roundTrip is the innermost Handler of the client, sending the request
+// to the YNAB API and decoding its response
+func (c *client) roundTrip(ctx context.Context, req *Request) error {
+	canRetry := c.retryPolicy.canRetry(ctx, req.Method)
+	refreshed := false
+
+	for attempt := 1; ; attempt++ {
+		if err := c.waitRateLimit(ctx); err != nil {
+			return err
+		}
+
+		res, body, err := c.send(ctx, req)
+		if canRetry && attempt < c.retryPolicy.MaxAttempts &&
+			c.retryPolicy.shouldRetry(ctx, res, err) {
+
+			if d, ok := c.retryPolicy.delay(attempt, res); ok {
+				if err := sleep(ctx, d); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		if err != nil {
+			return err
+		}
+
+		if res.StatusCode == http.StatusUnauthorized && c.tokenSource != nil && !refreshed {
+			ok, err := c.refreshAuthorization(ctx, req)
+			if err != nil {
+				return err
+			}
+			if ok {
+				refreshed = true
+				continue
+			}
+		}
+
+		if res.StatusCode >= 400 {
+			apiError := parseAPIError(req.Method, req.URL, res.StatusCode, body)
+			if res.StatusCode == http.StatusTooManyRequests {
+				return c.rateLimitError(res, apiError)
+			}
+			return apiError
+		}
+
+		return json.Unmarshal(body, &req.ResponseModel)
+	}
+}
+
+// send sends a single request attempt to the YNAB API, returning the
+// response along with its fully read body
+func (c *client) send(ctx context.Context, req *Request) (*http.Response, []byte, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, req.Method, req.URL, bytes.NewBuffer(req.Body))
 	if err != nil {
-		return err
+		return nil, nil, err
+	}
+	httpReq.Header = req.Header.Clone()
+
+	res, err := c.client.Do(httpReq)
+	if err != nil {
+		return nil, nil, err
 	}
 	defer res.Body.Close()
 
+	c.updateRateLimit(res)
+
 	body, err := io.ReadAll(res.Body)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	if res.StatusCode >= 400 {
-		response := struct {
-			Error *api.Error `json:"error"`
-		}{}
-
-		if err := json.Unmarshal(body, &response); err != nil {
-			// returns a forged *api.Error fore ease of use
-			// because either the response body is empty or the response is
-			// non compliant with YNAB's API specification
-			// https://api.youneedabudget.com/#errors
-			apiError := &api.Error{
-				ID:     strconv.Itoa(res.StatusCode),
-				Name:   "unknown_api_error",
-				Detail: "Unknown API error",
-			}
-			return apiError
-		}
+	req.Response = &Response{
+		StatusCode: res.StatusCode,
+		Header:     res.Header,
+		Body:       body,
+	}
+	return res, body, nil
+}
+
+// parseAPIError parses the error returned by the YNAB API
+func parseAPIError(method, url string, statusCode int, body []byte) *api.Error {
+	response := struct {
+		Error *api.Error `json:"error"`
+	}{}
 
-		return response.Error
+	if err := json.Unmarshal(body, &response); err != nil || response.Error == nil {
+		// returns a forged *api.Error fore ease of use
+		// because either the response body is empty or the response is
+		// non compliant with YNAB's API specification
+		// https://api.youneedabudget.com/#errors
+		response.Error = &api.Error{
+			ID:     strconv.Itoa(statusCode),
+			Name:   "unknown_api_error",
+			Detail: "Unknown API error",
+		}
 	}
 
-	return json.Unmarshal(body, &responseModel)
+	response.Error.StatusCode = statusCode
+	response.Error.Method = method
+	response.Error.URL = url
+	response.Error.Body = body
+	return response.Error
 }
@@ -5,6 +5,8 @@
 package ynab
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -12,6 +14,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/jarcoal/httpmock.v1"
+
+	"github.com/brunomvsouza/ynab.go/api"
 )
 
 func TestClient_GET(t *testing.T) {
@@ -80,7 +84,7 @@ func TestClient_GET(t *testing.T) {
 			Foo string `json:"foo"`
 		}{}
 
-		c := NewClient("")
+		c := NewClient("", WithRetryPolicy(RetryPolicy{MaxAttempts: 1}))
 		err := c.(*client).GET("/foo", &response)
 		expectedErrStr := "api: error id=500 name=unknown_api_error detail=Unknown API error"
 		assert.EqualError(t, err, expectedErrStr)
@@ -587,3 +591,133 @@ func TestClient_DELETE(t *testing.T) {
 		}{}, response)
 	})
 }
+
+func TestClient_WithContext(t *testing.T) {
+	type ctxKey struct{}
+
+	table := []struct {
+		Method string
+		Call   func(c *client, ctx context.Context, response interface{}) error
+	}{
+		{http.MethodGet, func(c *client, ctx context.Context, response interface{}) error {
+			return c.GETWithContext(ctx, "/foo", response)
+		}},
+		{http.MethodPost, func(c *client, ctx context.Context, response interface{}) error {
+			return c.POSTWithContext(ctx, "/foo", response, []byte(`{"bar":"foo"}`))
+		}},
+		{http.MethodPut, func(c *client, ctx context.Context, response interface{}) error {
+			return c.PUTWithContext(ctx, "/foo", response, []byte(`{"bar":"foo"}`))
+		}},
+		{http.MethodPatch, func(c *client, ctx context.Context, response interface{}) error {
+			return c.PATCHWithContext(ctx, "/foo", response, []byte(`{"bar":"foo"}`))
+		}},
+		{http.MethodDelete, func(c *client, ctx context.Context, response interface{}) error {
+			return c.DELETEWithContext(ctx, "/foo", response)
+		}},
+	}
+
+	for _, test := range table {
+		t.Run(test.Method, func(t *testing.T) {
+			httpmock.Activate()
+			defer httpmock.DeactivateAndReset()
+
+			httpmock.RegisterResponder(test.Method, fmt.Sprintf("%s%s", apiEndpoint, "/foo"),
+				func(req *http.Request) (*http.Response, error) {
+					assert.Equal(t, "bar", req.Context().Value(ctxKey{}))
+					return httpmock.NewStringResponse(http.StatusOK, `{"foo":"bar"}`), nil
+				},
+			)
+
+			response := struct {
+				Foo string `json:"foo"`
+			}{}
+
+			ctx := context.WithValue(context.Background(), ctxKey{}, "bar")
+			c := NewClient("")
+			err := test.Call(c.(*client), ctx, &response)
+			assert.NoError(t, err)
+			assert.Equal(t, "bar", response.Foo)
+		})
+	}
+
+	t.Run("cancelled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		response := struct {
+			Foo string `json:"foo"`
+		}{}
+
+		c := NewClient("")
+		err := c.(*client).GETWithContext(ctx, "/foo", &response)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestClient_errors(t *testing.T) {
+	t.Run("expected API error", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		body := `{"error":{"id":"404.2","name":"resource_not_found","detail":"Resource not found"}}`
+		httpmock.RegisterResponder(http.MethodPatch, fmt.Sprintf("%s%s", apiEndpoint, "/foo"),
+			func(req *http.Request) (*http.Response, error) {
+				return httpmock.NewStringResponse(http.StatusNotFound, body), nil
+			},
+		)
+
+		c := NewClient("")
+		err := c.(*client).PATCH("/foo", &struct{}{}, []byte(`{}`))
+		assert.ErrorIs(t, err, api.ErrNotFound)
+
+		var apiErr *api.Error
+		assert.True(t, errors.As(err, &apiErr))
+		assert.Equal(t, &api.Error{
+			ID:         "404.2",
+			Name:       "resource_not_found",
+			Detail:     "Resource not found",
+			StatusCode: http.StatusNotFound,
+			Method:     http.MethodPatch,
+			URL:        fmt.Sprintf("%s%s", apiEndpoint, "/foo"),
+			Body:       []byte(body),
+		}, apiErr)
+	})
+
+	t.Run("unexpected API error", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		httpmock.RegisterResponder(http.MethodGet, fmt.Sprintf("%s%s", apiEndpoint, "/foo"),
+			func(req *http.Request) (*http.Response, error) {
+				return httpmock.NewStringResponse(http.StatusServiceUnavailable, "Service Unavailable"), nil
+			},
+		)
+
+		c := NewClient("", WithRetryPolicy(RetryPolicy{MaxAttempts: 1}))
+		err := c.(*client).GET("/foo", &struct{}{})
+		assert.ErrorIs(t, err, api.ErrServiceUnavailable)
+
+		var apiErr *api.Error
+		assert.True(t, errors.As(err, &apiErr))
+		assert.Equal(t, "503", apiErr.ID)
+		assert.Equal(t, "unknown_api_error", apiErr.Name)
+		assert.Equal(t, http.StatusServiceUnavailable, apiErr.StatusCode)
+		assert.Equal(t, []byte("Service Unavailable"), apiErr.Body)
+	})
+
+	t.Run("error field missing", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		httpmock.RegisterResponder(http.MethodGet, fmt.Sprintf("%s%s", apiEndpoint, "/foo"),
+			func(req *http.Request) (*http.Response, error) {
+				return httpmock.NewStringResponse(http.StatusUnauthorized, `{}`), nil
+			},
+		)
+
+		c := NewClient("")
+		err := c.(*client).GET("/foo", &struct{}{})
+		assert.ErrorIs(t, err, api.ErrUnauthorized)
+		assert.EqualError(t, err, "api: error id=401 name=unknown_api_error detail=Unknown API error")
+	})
+}
@@ -6,7 +6,9 @@ package ynab_test
 
 import (
 	"fmt"
+	"net/http"
 	"reflect"
+	"time"
 
 	"github.com/brunomvsouza/ynab.go"
 )
@@ -71,3 +73,12 @@ func ExampleClientServicer_Transaction() {
 
 	// Output: *transaction.Service
 }
+
+func ExampleNewClient_options() {
+	c := ynab.NewClient("<valid_ynab_access_token>",
+		ynab.WithHTTPClient(&http.Client{Timeout: 10 * time.Second}),
+		ynab.WithUserAgent("my-app/1.0"),
+		ynab.WithHeader("X-Request-Source", "nightly-sync"),
+	)
+	c.User().GetUser() //nolint:errcheck
+}
@@ -0,0 +1,186 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package ynab
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/brunomvsouza/ynab.go/api"
+)
+
+const (
+	redacted = "[REDACTED]"
+	masked   = "[MASKED]"
+)
+
+// sensitiveFields the body fields masked when LogOptions.MaskSensitive is set
+var sensitiveFields = map[string]bool{
+	"amount":                     true,
+	"balance":                    true,
+	"cleared_balance":            true,
+	"uncleared_balance":          true,
+	"budgeted":                   true,
+	"activity":                   true,
+	"income":                     true,
+	"to_be_budgeted":             true,
+	"debt_original_balance":      true,
+	"debt_interest_rates":        true,
+	"debt_minimum_payments":      true,
+	"debt_escrow_amounts":        true,
+	"goal_target":                true,
+	"goal_under_funded":          true,
+	"goal_overall_funded":        true,
+	"goal_overall_left":          true,
+	"memo":                       true,
+	"payee_name":                 true,
+	"import_payee_name":          true,
+	"import_payee_name_original": true,
+}
+
+// sensitiveNestedFields the body fields masked when LogOptions.MaskSensitive
+// is set, by the key of their enclosing object or list of objects
+var sensitiveNestedFields = map[string]map[string]bool{
+	"payee":  {"name": true},
+	"payees": {"name": true},
+}
+
+// LogOptions configures what is logged by WithLogger
+type LogOptions struct {
+	// Bodies logs the request and response bodies at debug level
+	Bodies bool
+	// MaskSensitive masks amounts, memos and payee names in the
+	// logged bodies
+	MaskSensitive bool
+}
+
+// WithLogger logs every request sent by the client: method, path, status,
+// duration, rate limit usage and error IDs. Failed requests are logged at
+// error level and the others at info level. The access token is always
+// redacted.
+func WithLogger(logger *slog.Logger, opts LogOptions) Option {
+	return WithMiddleware(loggingMiddleware(logger, opts))
+}
+
+// loggingMiddleware builds the Middleware behind WithLogger
+func loggingMiddleware(logger *slog.Logger, opts LogOptions) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, req *Request) error {
+			started := time.Now()
+			err := next(ctx, req)
+			duration := time.Since(started)
+
+			token := strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+			attrs := []slog.Attr{
+				slog.String("method", req.Method),
+				slog.String("path", redact(requestPath(req.URL), token)),
+				slog.Duration("duration", duration),
+			}
+
+			if req.Response != nil {
+				attrs = append(attrs, slog.Int("status", req.Response.StatusCode))
+				if rl := req.Response.Header.Get("X-Rate-Limit"); rl != "" {
+					attrs = append(attrs, slog.String("rate_limit", rl))
+				}
+			}
+
+			var apiErr *api.Error
+			if errors.As(err, &apiErr) {
+				attrs = append(attrs,
+					slog.String("error_id", apiErr.ID),
+					slog.String("error_name", apiErr.Name),
+				)
+			} else if err != nil {
+				attrs = append(attrs, slog.String("error", redact(err.Error(), token)))
+			}
+
+			level := slog.LevelInfo
+			if err != nil {
+				level = slog.LevelError
+			}
+			logger.LogAttrs(ctx, level, "ynab request", attrs...)
+
+			if opts.Bodies && logger.Enabled(ctx, slog.LevelDebug) {
+				bodyAttrs := []slog.Attr{
+					slog.String("method", req.Method),
+					slog.String("path", redact(requestPath(req.URL), token)),
+				}
+				if len(req.Body) > 0 {
+					bodyAttrs = append(bodyAttrs, slog.String("request_body",
+						redact(logBody(req.Body, opts.MaskSensitive), token)))
+				}
+				if req.Response != nil && len(req.Response.Body) > 0 {
+					bodyAttrs = append(bodyAttrs, slog.String("response_body",
+						redact(logBody(req.Response.Body, opts.MaskSensitive), token)))
+				}
+				logger.LogAttrs(ctx, slog.LevelDebug, "ynab request bodies", bodyAttrs...)
+			}
+
+			return err
+		}
+	}
+}
+
+// requestPath returns the path and query of a request URL
+func requestPath(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	return u.RequestURI()
+}
+
+// redact replaces every occurrence of the access token in s
+func redact(s, token string) string {
+	if token == "" {
+		return s
+	}
+	return strings.ReplaceAll(s, token, redacted)
+}
+
+// logBody returns the body as it should be logged, masking its sensitive
+// fields when requested
+func logBody(body []byte, mask bool) string {
+	if !mask {
+		return string(body)
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return masked
+	}
+
+	buf, err := json.Marshal(maskSensitive(v, ""))
+	if err != nil {
+		return masked
+	}
+	return string(buf)
+}
+
+// maskSensitive masks the sensitive fields of a decoded JSON value
+// found under the given key
+func maskSensitive(v interface{}, key string) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		nested := sensitiveNestedFields[key]
+		for k, value := range v {
+			if (sensitiveFields[k] || nested[k]) && value != nil {
+				v[k] = masked
+				continue
+			}
+			v[k] = maskSensitive(value, k)
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = maskSensitive(value, key)
+		}
+	}
+	return v
+}
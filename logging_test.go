@@ -0,0 +1,154 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package ynab
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+)
+
+const testAccessToken = "6zL9vh8]B9H3BEecwL%Vzh^VwKR3C2CNZ3Bv%=fFxm$z)duY[U+2=3CydZrkQFnA"
+
+func decodeLogLines(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	var lines []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		entry := make(map[string]interface{})
+		assert.NoError(t, json.Unmarshal([]byte(line), &entry))
+		lines = append(lines, entry)
+	}
+	return lines
+}
+
+func TestWithLogger(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		httpmock.RegisterResponder(http.MethodGet, fmt.Sprintf("%s%s", apiEndpoint, "/budgets?include_accounts=true"),
+			func(req *http.Request) (*http.Response, error) {
+				res := httpmock.NewStringResponse(http.StatusOK, `{"data":{}}`)
+				res.Header.Add("X-Rate-Limit", "36/200")
+				return res, nil
+			},
+		)
+
+		buf := new(bytes.Buffer)
+		logger := slog.New(slog.NewJSONHandler(buf, nil))
+
+		c := NewClient(testAccessToken, WithLogger(logger, LogOptions{}))
+		err := c.(*client).GET("/budgets?include_accounts=true", &struct{}{})
+		assert.NoError(t, err)
+
+		lines := decodeLogLines(t, buf)
+		assert.Len(t, lines, 1)
+		assert.Equal(t, "INFO", lines[0]["level"])
+		assert.Equal(t, "ynab request", lines[0]["msg"])
+		assert.Equal(t, http.MethodGet, lines[0]["method"])
+		assert.Equal(t, "/v1/budgets?include_accounts=true", lines[0]["path"])
+		assert.Equal(t, float64(http.StatusOK), lines[0]["status"])
+		assert.Equal(t, "36/200", lines[0]["rate_limit"])
+		assert.Contains(t, lines[0], "duration")
+		assert.NotContains(t, buf.String(), testAccessToken)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		httpmock.RegisterResponder(http.MethodGet, fmt.Sprintf("%s%s", apiEndpoint, "/foo"),
+			func(req *http.Request) (*http.Response, error) {
+				return httpmock.NewStringResponse(http.StatusNotFound,
+					`{"error":{"id":"404.2","name":"resource_not_found","detail":"Resource not found"}}`), nil
+			},
+		)
+
+		buf := new(bytes.Buffer)
+		logger := slog.New(slog.NewJSONHandler(buf, nil))
+
+		c := NewClient(testAccessToken, WithLogger(logger, LogOptions{}))
+		err := c.(*client).GET("/foo", &struct{}{})
+		assert.Error(t, err)
+
+		lines := decodeLogLines(t, buf)
+		assert.Len(t, lines, 1)
+		assert.Equal(t, "ERROR", lines[0]["level"])
+		assert.Equal(t, float64(http.StatusNotFound), lines[0]["status"])
+		assert.Equal(t, "404.2", lines[0]["error_id"])
+		assert.Equal(t, "resource_not_found", lines[0]["error_name"])
+	})
+
+	t.Run("bodies with sensitive data masked", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		httpmock.RegisterResponder(http.MethodPost, fmt.Sprintf("%s%s", apiEndpoint, "/foo"),
+			func(req *http.Request) (*http.Response, error) {
+				return httpmock.NewStringResponse(http.StatusOK,
+					`{"data":{"transactions":[{"id":"a","amount":-9000,"memo":"secret","payee_name":"Shop","flag_color":null}],"token":"`+
+						testAccessToken+`"}}`), nil
+			},
+		)
+
+		buf := new(bytes.Buffer)
+		logger := slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+		c := NewClient(testAccessToken, WithLogger(logger, LogOptions{Bodies: true, MaskSensitive: true}))
+		err := c.(*client).POST("/foo", &struct{}{}, []byte(`{"transaction":{"amount":1000,"memo":null}}`))
+		assert.NoError(t, err)
+
+		lines := decodeLogLines(t, buf)
+		assert.Len(t, lines, 2)
+		assert.Equal(t, "DEBUG", lines[1]["level"])
+		assert.Equal(t, `{"transaction":{"amount":"[MASKED]","memo":null}}`, lines[1]["request_body"])
+		assert.Equal(t, `{"data":{"token":"[REDACTED]","transactions":[{"amount":"[MASKED]",`+
+			`"flag_color":null,"id":"a","memo":"[MASKED]","payee_name":"[MASKED]"}]}}`, lines[1]["response_body"])
+		assert.NotContains(t, buf.String(), testAccessToken)
+	})
+
+	t.Run("bodies not logged above debug level", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		httpmock.RegisterResponder(http.MethodGet, fmt.Sprintf("%s%s", apiEndpoint, "/foo"),
+			func(req *http.Request) (*http.Response, error) {
+				return httpmock.NewStringResponse(http.StatusOK, `{"amount":1}`), nil
+			},
+		)
+
+		buf := new(bytes.Buffer)
+		logger := slog.New(slog.NewJSONHandler(buf, nil))
+
+		c := NewClient(testAccessToken, WithLogger(logger, LogOptions{Bodies: true}))
+		err := c.(*client).GET("/foo", &struct{}{})
+		assert.NoError(t, err)
+		assert.Len(t, decodeLogLines(t, buf), 1)
+	})
+}
+
+func TestLogBody(t *testing.T) {
+	assert.Equal(t, `{"amount":1}`, logBody([]byte(`{"amount":1}`), false))
+	assert.Equal(t, `{"amount":"[MASKED]"}`, logBody([]byte(`{"amount":1}`), true))
+	assert.Equal(t, `[MASKED]`, logBody([]byte(`not json`), true))
+
+	payees := `{"data":{"payees":[{"id":"p1","name":"Supermarket"}],"server_knowledge":10}}`
+	assert.Equal(t,
+		`{"data":{"payees":[{"id":"p1","name":"[MASKED]"}],"server_knowledge":10}}`,
+		logBody([]byte(payees), true))
+
+	payee := `{"payee":{"name":"Supermarket"}}`
+	assert.Equal(t, `{"payee":{"name":"[MASKED]"}}`, logBody([]byte(payee), true))
+
+	// names of other entities are not masked
+	account := `{"data":{"account":{"id":"a1","name":"Checking"}}}`
+	assert.Equal(t, account, logBody([]byte(account), true))
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package ynab
+
+import (
+	"context"
+	"net/http"
+)
+
+// Request represents a call to the YNAB API as seen by a Middleware
+type Request struct {
+	Method string
+	// URL the full URL of the request
+	URL string
+	// Header the headers sent with the request, including Authorization
+	Header http.Header
+	// Body the raw body of the request, nil when there is none
+	Body []byte
+	// ResponseModel the value the response body is decoded into
+	ResponseModel interface{}
+	// Response the response of the API, nil until the request is sent
+	// or when the request was short-circuited
+	Response *Response
+}
+
+// Response represents a response of the YNAB API as seen by a Middleware
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	// Body the raw body of the response
+	Body []byte
+}
+
+// Handler sends a Request to the YNAB API and decodes its response into
+// the Request.ResponseModel
+type Handler func(ctx context.Context, req *Request) error
+
+// Middleware wraps a Handler with cross-cutting behaviour, e.g. logging,
+// metrics or header rewriting. A middleware may inspect and modify the
+// request before calling next, inspect the response and the decoded
+// result after it returns, or short-circuit the request altogether by
+// not calling next.
+type Middleware func(next Handler) Handler
+
+// WithMiddleware adds middlewares wrapping every request sent by the
+// client. Middlewares are called in the order they are given, the first
+// one being the outermost.
+func WithMiddleware(middlewares ...Middleware) Option {
+	return func(c *client) {
+		c.middlewares = append(c.middlewares, middlewares...)
+	}
+}
+
+// chain wraps h with the given middlewares, the first one being the outermost
+func chain(h Handler, middlewares []Middleware) Handler {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		h = middlewares[i](h)
+	}
+	return h
+}
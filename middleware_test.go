@@ -0,0 +1,132 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package ynab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+)
+
+func TestWithMiddleware(t *testing.T) {
+	t.Run("wraps requests in order", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		httpmock.RegisterResponder(http.MethodGet, fmt.Sprintf("%s%s", apiEndpoint, "/foo"),
+			func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, "Bearer rewritten", req.Header.Get("Authorization"))
+				res := httpmock.NewStringResponse(http.StatusOK, `{"foo":"bar"}`)
+				res.Header.Add("X-Rate-Limit", "36/200")
+				return res, nil
+			},
+		)
+
+		var calls []string
+		record := func(name string) Middleware {
+			return func(next Handler) Handler {
+				return func(ctx context.Context, req *Request) error {
+					calls = append(calls, name+":before")
+					err := next(ctx, req)
+					calls = append(calls, name+":after")
+					return err
+				}
+			}
+		}
+		rewriteAuth := func(next Handler) Handler {
+			return func(ctx context.Context, req *Request) error {
+				assert.Equal(t, http.MethodGet, req.Method)
+				assert.Equal(t, fmt.Sprintf("%s%s", apiEndpoint, "/foo"), req.URL)
+				assert.Equal(t, "Bearer token", req.Header.Get("Authorization"))
+				assert.Nil(t, req.Response)
+
+				req.Header.Set("Authorization", "Bearer rewritten")
+				err := next(ctx, req)
+
+				assert.Equal(t, http.StatusOK, req.Response.StatusCode)
+				assert.Equal(t, "36/200", req.Response.Header.Get("X-Rate-Limit"))
+				assert.Equal(t, `{"foo":"bar"}`, string(req.Response.Body))
+				assert.Equal(t, "bar", req.ResponseModel.(*struct {
+					Foo string `json:"foo"`
+				}).Foo)
+				return err
+			}
+		}
+
+		response := struct {
+			Foo string `json:"foo"`
+		}{}
+
+		c := NewClient("token",
+			WithMiddleware(record("first"), record("second")),
+			WithMiddleware(rewriteAuth),
+		)
+		err := c.(*client).GET("/foo", &response)
+		assert.NoError(t, err)
+		assert.Equal(t, "bar", response.Foo)
+		assert.Equal(t, []string{"first:before", "second:before", "second:after", "first:after"}, calls)
+	})
+
+	t.Run("short-circuits requests", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		calls := 0
+		httpmock.RegisterResponder(http.MethodGet, fmt.Sprintf("%s%s", apiEndpoint, "/foo"),
+			func(req *http.Request) (*http.Response, error) {
+				calls++
+				return httpmock.NewStringResponse(http.StatusOK, `{"foo":"bar"}`), nil
+			},
+		)
+
+		cached := func(next Handler) Handler {
+			return func(ctx context.Context, req *Request) error {
+				req.ResponseModel.(*struct {
+					Foo string `json:"foo"`
+				}).Foo = "cached"
+				return nil
+			}
+		}
+
+		response := struct {
+			Foo string `json:"foo"`
+		}{}
+
+		c := NewClient("", WithMiddleware(cached))
+		err := c.(*client).GET("/foo", &response)
+		assert.NoError(t, err)
+		assert.Equal(t, "cached", response.Foo)
+		assert.Equal(t, 0, calls)
+	})
+
+	t.Run("observes errors", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		httpmock.RegisterResponder(http.MethodDelete, fmt.Sprintf("%s%s", apiEndpoint, "/foo"),
+			func(req *http.Request) (*http.Response, error) {
+				return httpmock.NewStringResponse(http.StatusInternalServerError, ""), nil
+			},
+		)
+
+		var observed error
+		observe := func(next Handler) Handler {
+			return func(ctx context.Context, req *Request) error {
+				observed = next(ctx, req)
+				return observed
+			}
+		}
+
+		c := NewClient("", WithMiddleware(observe))
+		err := c.(*client).DELETE("/foo", &struct{}{})
+		assert.Error(t, err)
+		assert.True(t, errors.Is(err, observed))
+	})
+}
@@ -0,0 +1,170 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+// Package oauth implements the OAuth 2.0 flows supported by the YNAB API
+// https://api.youneedabudget.com/#oauth-applications
+package oauth // import "github.com/brunomvsouza/ynab.go/oauth"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// AuthURL the YNAB OAuth authorization endpoint
+	AuthURL = "https://app.youneedabudget.com/oauth/authorize"
+	// TokenURL the YNAB OAuth token endpoint
+	TokenURL = "https://app.youneedabudget.com/oauth/token"
+)
+
+// Config describes an OAuth application registered on YNAB
+type Config struct {
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	// ReadOnly requests a read-only access token
+	ReadOnly bool
+
+	// AuthURL overrides the authorization endpoint. Defaults to AuthURL.
+	AuthURL string
+	// TokenURL overrides the token endpoint, e.g. with a local fake
+	// token endpoint for tests. Defaults to TokenURL.
+	TokenURL string
+	// HTTPClient the HTTP client used to reach the token endpoint.
+	// Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+// AuthCodeURL returns the URL of the consent page users must be redirected
+// to in the authorization code grant flow. The authorization code is sent
+// back to RedirectURL along with state.
+func (c *Config) AuthCodeURL(state string) string {
+	return c.authURL("code", state)
+}
+
+// ImplicitGrantURL returns the URL of the consent page users must be
+// redirected to in the implicit grant flow. The access token is sent back
+// to RedirectURL in the URL fragment along with state.
+func (c *Config) ImplicitGrantURL(state string) string {
+	return c.authURL("token", state)
+}
+
+func (c *Config) authURL(responseType, state string) string {
+	v := url.Values{
+		"client_id":     {c.ClientID},
+		"redirect_uri":  {c.RedirectURL},
+		"response_type": {responseType},
+	}
+	if state != "" {
+		v.Set("state", state)
+	}
+	if c.ReadOnly {
+		v.Set("scope", "read-only")
+	}
+
+	authURL := c.AuthURL
+	if authURL == "" {
+		authURL = AuthURL
+	}
+	return fmt.Sprintf("%s?%s", authURL, v.Encode())
+}
+
+// Exchange exchanges an authorization code for a token
+func (c *Config) Exchange(ctx context.Context, code string) (*Token, error) {
+	return c.retrieveToken(ctx, url.Values{
+		"grant_type":   {"authorization_code"},
+		"code":         {code},
+		"redirect_uri": {c.RedirectURL},
+	})
+}
+
+// Refresh obtains a new token from a refresh token. YNAB rotates refresh
+// tokens, so the returned token must replace the previous one.
+func (c *Config) Refresh(ctx context.Context, refreshToken string) (*Token, error) {
+	return c.retrieveToken(ctx, url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {refreshToken},
+	})
+}
+
+func (c *Config) retrieveToken(ctx context.Context, v url.Values) (*Token, error) {
+	v.Set("client_id", c.ClientID)
+	v.Set("client_secret", c.ClientSecret)
+
+	tokenURL := c.TokenURL
+	if tokenURL == "" {
+		tokenURL = TokenURL
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL,
+		strings.NewReader(v.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode >= 400 {
+		e := &Error{StatusCode: res.StatusCode}
+		if err := json.Unmarshal(body, e); err != nil {
+			e.Code = "unknown_oauth_error"
+		}
+		return nil, e
+	}
+
+	resModel := struct {
+		AccessToken  string `json:"access_token"`
+		TokenType    string `json:"token_type"`
+		RefreshToken string `json:"refresh_token"`
+		ExpiresIn    int64  `json:"expires_in"`
+	}{}
+	if err := json.Unmarshal(body, &resModel); err != nil {
+		return nil, err
+	}
+
+	t := &Token{
+		AccessToken:  resModel.AccessToken,
+		TokenType:    resModel.TokenType,
+		RefreshToken: resModel.RefreshToken,
+	}
+	if resModel.ExpiresIn > 0 {
+		t.Expiry = time.Now().Add(time.Duration(resModel.ExpiresIn) * time.Second)
+	}
+	return t, nil
+}
+
+// Error represents an error returned by the token endpoint
+type Error struct {
+	StatusCode  int    `json:"-"`
+	Code        string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+// Error returns the string version of the error
+func (e Error) Error() string {
+	return fmt.Sprintf("oauth: error status=%d code=%s description=%s",
+		e.StatusCode, e.Code, e.Description)
+}
@@ -0,0 +1,229 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package oauth_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/brunomvsouza/ynab.go/oauth"
+)
+
+// newTokenServer starts a fake token endpoint answering with body for
+// every request whose form values match want
+func newTokenServer(t *testing.T, want url.Values, status int, body string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.NoError(t, r.ParseForm())
+		for key := range want {
+			assert.Equal(t, want.Get(key), r.PostForm.Get(key), key)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		w.Write([]byte(body)) //nolint:errcheck
+	}))
+}
+
+func TestConfig_AuthCodeURL(t *testing.T) {
+	c := &oauth.Config{
+		ClientID:    "client-id",
+		RedirectURL: "https://example.com/callback",
+	}
+
+	u, err := url.Parse(c.AuthCodeURL("xyz"))
+	assert.NoError(t, err)
+	assert.Equal(t, "app.youneedabudget.com", u.Host)
+	assert.Equal(t, "/oauth/authorize", u.Path)
+	assert.Equal(t, url.Values{
+		"client_id":     {"client-id"},
+		"redirect_uri":  {"https://example.com/callback"},
+		"response_type": {"code"},
+		"state":         {"xyz"},
+	}, u.Query())
+}
+
+func TestConfig_ImplicitGrantURL(t *testing.T) {
+	c := &oauth.Config{
+		ClientID:    "client-id",
+		RedirectURL: "https://example.com/callback",
+		ReadOnly:    true,
+		AuthURL:     "https://auth.example.com/authorize",
+	}
+
+	u, err := url.Parse(c.ImplicitGrantURL(""))
+	assert.NoError(t, err)
+	assert.Equal(t, "auth.example.com", u.Host)
+	assert.Equal(t, url.Values{
+		"client_id":     {"client-id"},
+		"redirect_uri":  {"https://example.com/callback"},
+		"response_type": {"token"},
+		"scope":         {"read-only"},
+	}, u.Query())
+}
+
+func TestConfig_Exchange(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		server := newTokenServer(t, url.Values{
+			"grant_type":    {"authorization_code"},
+			"code":          {"auth-code"},
+			"redirect_uri":  {"https://example.com/callback"},
+			"client_id":     {"client-id"},
+			"client_secret": {"client-secret"},
+		}, http.StatusOK, `{
+  "access_token": "access-token",
+  "token_type": "bearer",
+  "expires_in": 7200,
+  "refresh_token": "refresh-token"
+}`)
+		defer server.Close()
+
+		c := &oauth.Config{
+			ClientID:     "client-id",
+			ClientSecret: "client-secret",
+			RedirectURL:  "https://example.com/callback",
+			TokenURL:     server.URL,
+		}
+
+		tok, err := c.Exchange(context.Background(), "auth-code")
+		assert.NoError(t, err)
+		assert.Equal(t, "access-token", tok.AccessToken)
+		assert.Equal(t, "bearer", tok.TokenType)
+		assert.Equal(t, "refresh-token", tok.RefreshToken)
+		assert.WithinDuration(t, time.Now().Add(2*time.Hour), tok.Expiry, time.Minute)
+		assert.True(t, tok.Valid())
+	})
+
+	t.Run("error", func(t *testing.T) {
+		server := newTokenServer(t, nil, http.StatusUnauthorized, `{
+  "error": "invalid_grant",
+  "error_description": "The provided authorization grant is invalid"
+}`)
+		defer server.Close()
+
+		c := &oauth.Config{TokenURL: server.URL}
+
+		tok, err := c.Exchange(context.Background(), "bad-code")
+		assert.Nil(t, tok)
+
+		var oauthErr *oauth.Error
+		assert.True(t, errors.As(err, &oauthErr))
+		assert.Equal(t, http.StatusUnauthorized, oauthErr.StatusCode)
+		assert.Equal(t, "invalid_grant", oauthErr.Code)
+		assert.Equal(t, "oauth: error status=401 code=invalid_grant description=The provided authorization grant is invalid",
+			err.Error())
+	})
+}
+
+func TestToken_Valid(t *testing.T) {
+	var nilToken *oauth.Token
+	assert.False(t, nilToken.Valid())
+	assert.False(t, (&oauth.Token{}).Valid())
+	assert.True(t, (&oauth.Token{AccessToken: "a"}).Valid())
+	assert.True(t, (&oauth.Token{AccessToken: "a", Expiry: time.Now().Add(time.Hour)}).Valid())
+	assert.False(t, (&oauth.Token{AccessToken: "a", Expiry: time.Now().Add(time.Second)}).Valid())
+}
+
+func TestRefreshingTokenSource(t *testing.T) {
+	server := newTokenServer(t, url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {"old-refresh-token"},
+	}, http.StatusOK, `{
+  "access_token": "new-access-token",
+  "token_type": "bearer",
+  "expires_in": 7200,
+  "refresh_token": "new-refresh-token"
+}`)
+	defer server.Close()
+
+	c := &oauth.Config{TokenURL: server.URL}
+
+	t.Run("valid token is reused", func(t *testing.T) {
+		valid := &oauth.Token{AccessToken: "access-token", Expiry: time.Now().Add(time.Hour)}
+		ts := c.TokenSource(valid, nil)
+
+		tok, err := ts.Token(context.Background())
+		assert.NoError(t, err)
+		assert.Same(t, valid, tok)
+	})
+
+	t.Run("expired token is refreshed and persisted", func(t *testing.T) {
+		var persisted *oauth.Token
+		ts := c.TokenSource(&oauth.Token{
+			AccessToken:  "old-access-token",
+			RefreshToken: "old-refresh-token",
+			Expiry:       time.Now().Add(-time.Minute),
+		}, func(tok *oauth.Token) error {
+			persisted = tok
+			return nil
+		})
+
+		tok, err := ts.Token(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "new-access-token", tok.AccessToken)
+		assert.Equal(t, "new-refresh-token", tok.RefreshToken)
+		assert.Same(t, tok, persisted)
+	})
+
+	t.Run("persist error", func(t *testing.T) {
+		persistErr := errors.New("disk full")
+		ts := c.TokenSource(&oauth.Token{RefreshToken: "old-refresh-token"},
+			func(*oauth.Token) error { return persistErr })
+
+		_, err := ts.Refresh(context.Background())
+		assert.Equal(t, persistErr, err)
+	})
+
+	t.Run("no refresh token", func(t *testing.T) {
+		ts := c.TokenSource(&oauth.Token{AccessToken: "access-token"}, nil)
+
+		_, err := ts.Refresh(context.Background())
+		assert.Equal(t, oauth.ErrNoRefreshToken, err)
+	})
+}
+
+func TestRefreshingTokenSource_keepsRefreshToken(t *testing.T) {
+	calls := 0
+	server := newTokenServer(t, url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {"old-refresh-token"},
+	}, http.StatusOK, `{
+  "access_token": "new-access-token",
+  "token_type": "bearer",
+  "expires_in": 7200
+}`)
+	defer server.Close()
+
+	c := &oauth.Config{TokenURL: server.URL}
+
+	var persisted *oauth.Token
+	ts := c.TokenSource(&oauth.Token{
+		AccessToken:  "old-access-token",
+		RefreshToken: "old-refresh-token",
+		Expiry:       time.Now().Add(-time.Minute),
+	}, func(tok *oauth.Token) error {
+		calls++
+		persisted = tok
+		return nil
+	})
+
+	tok, err := ts.Token(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "new-access-token", tok.AccessToken)
+	assert.Equal(t, "old-refresh-token", tok.RefreshToken)
+	assert.Equal(t, "old-refresh-token", persisted.RefreshToken)
+
+	// the kept refresh token is sent again on the next refresh
+	tok, err = ts.Refresh(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "old-refresh-token", tok.RefreshToken)
+	assert.Equal(t, 2, calls)
+}
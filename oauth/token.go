@@ -0,0 +1,129 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package oauth
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// expiryDelta how long before its expiry a token is considered expired,
+// so that it does not expire while a request is in flight
+const expiryDelta = 10 * time.Second
+
+// ErrNoRefreshToken is returned when a token must be refreshed but no
+// refresh token is available, e.g. tokens from the implicit grant flow
+var ErrNoRefreshToken = errors.New("oauth: no refresh token available")
+
+// Token represents an OAuth access token
+type Token struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	RefreshToken string `json:"refresh_token"`
+	// Expiry when the access token expires, zero when it never expires
+	Expiry time.Time `json:"expiry"`
+}
+
+// Valid tells whether the token has an access token which is not expired
+func (t *Token) Valid() bool {
+	if t == nil || t.AccessToken == "" {
+		return false
+	}
+	return t.Expiry.IsZero() || time.Now().Add(expiryDelta).Before(t.Expiry)
+}
+
+// TokenSource supplies the tokens used to authenticate requests. Callers
+// may implement it to load and persist tokens from their own storage.
+type TokenSource interface {
+	// Token returns a valid token
+	Token(ctx context.Context) (*Token, error)
+}
+
+// Refresher is implemented by a TokenSource able to refresh its token on
+// demand, e.g. after the API rejected it
+type Refresher interface {
+	// Refresh obtains and returns a new token
+	Refresh(ctx context.Context) (*Token, error)
+}
+
+// TokenSource returns a TokenSource which starts with t and refreshes it
+// whenever it expires. When not nil, persist is called with every
+// refreshed token so that it can be stored by the caller; an error
+// returned by persist is returned by Token and Refresh.
+func (c *Config) TokenSource(t *Token, persist func(*Token) error) *RefreshingTokenSource {
+	return &RefreshingTokenSource{
+		config:  c,
+		token:   t,
+		persist: persist,
+	}
+}
+
+// RefreshingTokenSource is a TokenSource which refreshes its token
+// whenever it expires. It is safe for concurrent use.
+type RefreshingTokenSource struct {
+	mu      sync.Mutex
+	config  *Config
+	token   *Token
+	persist func(*Token) error
+}
+
+// Token returns the current token, refreshing it first when expired
+func (s *RefreshingTokenSource) Token(ctx context.Context) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token.Valid() {
+		return s.token, nil
+	}
+	return s.refresh(ctx)
+}
+
+// Refresh refreshes the current token regardless of its expiry
+func (s *RefreshingTokenSource) Refresh(ctx context.Context) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.refresh(ctx)
+}
+
+func (s *RefreshingTokenSource) refresh(ctx context.Context) (*Token, error) {
+	if s.token == nil || s.token.RefreshToken == "" {
+		return nil, ErrNoRefreshToken
+	}
+
+	t, err := s.config.Refresh(ctx, s.token.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	// the token endpoint may not issue a new refresh token, in which case
+	// the current one stays valid
+	// https://datatracker.ietf.org/doc/html/rfc6749#section-6
+	if t.RefreshToken == "" {
+		t.RefreshToken = s.token.RefreshToken
+	}
+	s.token = t
+
+	if s.persist != nil {
+		if err := s.persist(t); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+// StaticTokenSource returns a TokenSource which always returns t
+func StaticTokenSource(t *Token) TokenSource {
+	return staticTokenSource{t}
+}
+
+type staticTokenSource struct {
+	t *Token
+}
+
+func (s staticTokenSource) Token(context.Context) (*Token, error) {
+	return s.t, nil
+}
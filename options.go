@@ -0,0 +1,49 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package ynab
+
+import (
+	"net/http"
+	"strings"
+)
+
+// Option configures optional behaviours of a client created by NewClient
+type Option func(c *client)
+
+// WithHTTPClient sets the HTTP client used to send requests to the
+// YNAB API. It allows the configuration of timeouts, proxies, TLS
+// settings and custom transports. Defaults to http.DefaultClient.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *client) {
+		if httpClient != nil {
+			c.client = httpClient
+		}
+	}
+}
+
+// WithBaseURL sets the base URL requests are sent to, e.g. a local
+// stand-in server for integration tests. Defaults to the YNAB API v1
+// endpoint.
+func WithBaseURL(baseURL string) Option {
+	return func(c *client) {
+		c.baseURL = strings.TrimSuffix(baseURL, "/")
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent on every request
+func WithUserAgent(userAgent string) Option {
+	return func(c *client) {
+		c.header.Set("User-Agent", userAgent)
+	}
+}
+
+// WithHeader adds a header sent on every request. The Accept,
+// Authorization and Content-Type headers are managed by the client
+// and cannot be overridden.
+func WithHeader(key, value string) Option {
+	return func(c *client) {
+		c.header.Add(key, value)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package ynab
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithHTTPClient(t *testing.T) {
+	t.Run("custom client", func(t *testing.T) {
+		httpClient := &http.Client{Timeout: time.Second}
+		c := NewClient("", WithHTTPClient(httpClient))
+		assert.Same(t, httpClient, c.(*client).client)
+	})
+
+	t.Run("nil client keeps the default", func(t *testing.T) {
+		c := NewClient("", WithHTTPClient(nil))
+		assert.Same(t, http.DefaultClient, c.(*client).client)
+	})
+}
+
+func TestWithBaseURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/foo", r.URL.Path)
+		w.Write([]byte(`{"foo":"bar"}`)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	response := struct {
+		Foo string `json:"foo"`
+	}{}
+
+	c := NewClient("", WithBaseURL(server.URL+"/v1/"))
+	err := c.(*client).GET("/foo", &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "bar", response.Foo)
+}
+
+func TestWithUserAgent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "my-app/1.0", r.Header.Get("User-Agent"))
+		w.Write([]byte(`{}`)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	c := NewClient("", WithBaseURL(server.URL), WithUserAgent("my-app/1.0"))
+	err := c.(*client).GET("/foo", &struct{}{})
+	assert.NoError(t, err)
+}
+
+func TestWithHeader(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, []string{"a", "b"}, r.Header.Values("X-Foo"))
+		assert.Equal(t, "application/json", r.Header.Get("Accept"))
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		w.Write([]byte(`{}`)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	c := NewClient("token",
+		WithBaseURL(server.URL),
+		WithHeader("X-Foo", "a"),
+		WithHeader("X-Foo", "b"),
+		WithHeader("Authorization", "Bearer not-the-token"),
+		WithHeader("Accept", "text/plain"),
+	)
+	err := c.(*client).GET("/foo", &struct{}{})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package ynab
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/brunomvsouza/ynab.go/api"
+)
+
+// RateLimitPolicy defines how the client behaves once the known request
+// quota of the access token is exhausted
+type RateLimitPolicy int
+
+const (
+	// RateLimitIgnore sends requests regardless of the known quota and
+	// lets the API refuse them. This is the default policy.
+	RateLimitIgnore RateLimitPolicy = iota
+	// RateLimitFailFast refuses to send requests while the known quota
+	// is exhausted, returning an *api.RateLimitError instead
+	RateLimitFailFast
+	// RateLimitWait blocks requests while the known quota is exhausted,
+	// until either the quota is expected to be available again or the
+	// request context is done
+	RateLimitWait
+)
+
+// WithRateLimitPolicy sets the behaviour of the client once the known
+// request quota of the access token is exhausted
+func WithRateLimitPolicy(p RateLimitPolicy) Option {
+	return func(c *client) {
+		c.rateLimitPolicy = p
+	}
+}
+
+// RateLimit returns the request quota usage of the access token as
+// last reported by the API. Both values are zero until the first
+// response is received.
+func (c *client) RateLimit() (used, limit int) {
+	c.Lock()
+	defer c.Unlock()
+	return c.rateLimit.Used, c.rateLimit.Limit
+}
+
+// waitRateLimit enforces the rate limit policy of the client before a
+// request is sent
+func (c *client) waitRateLimit(ctx context.Context) error {
+	if c.rateLimitPolicy == RateLimitIgnore {
+		return nil
+	}
+
+	c.Lock()
+	rl := c.rateLimit
+	wait := time.Until(c.rateLimitResetAt)
+	c.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+
+	if c.rateLimitPolicy == RateLimitFailFast {
+		return &api.RateLimitError{
+			RateLimit:  rl,
+			RetryAfter: wait,
+		}
+	}
+
+	return sleep(ctx, wait)
+}
+
+// updateRateLimit keeps track of the request quota usage reported
+// by the API
+func (c *client) updateRateLimit(res *http.Response) {
+	c.Lock()
+	defer c.Unlock()
+
+	if rl, err := api.ParseRateLimit(res.Header.Get("X-Rate-Limit")); err == nil {
+		c.rateLimit = rl
+	}
+
+	if res.StatusCode != http.StatusTooManyRequests && !c.rateLimit.Exceeded() {
+		c.rateLimitResetAt = time.Time{}
+		return
+	}
+
+	wait := parseRetryAfter(res.Header.Get("Retry-After"))
+	if wait <= 0 {
+		wait = api.RateLimitWindow
+	}
+	c.rateLimitResetAt = time.Now().Add(wait)
+}
+
+// rateLimitError builds the error returned when the API replies
+// with HTTP 429
+func (c *client) rateLimitError(res *http.Response, apiErr *api.Error) *api.RateLimitError {
+	c.Lock()
+	defer c.Unlock()
+
+	return &api.RateLimitError{
+		RateLimit:  c.rateLimit,
+		RetryAfter: parseRetryAfter(res.Header.Get("Retry-After")),
+		Err:        apiErr,
+	}
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which can be
+// either a number of seconds or an HTTP date. Returns zero when the value
+// is empty or invalid.
+func parseRetryAfter(s string) time.Duration {
+	if s == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(s); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(s); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
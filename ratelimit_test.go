@@ -0,0 +1,167 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package ynab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+
+	"github.com/brunomvsouza/ynab.go/api"
+)
+
+func TestClient_RateLimit(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(http.MethodGet, fmt.Sprintf("%s%s", apiEndpoint, "/foo"),
+		func(req *http.Request) (*http.Response, error) {
+			res := httpmock.NewStringResponse(http.StatusOK, `{}`)
+			res.Header.Add("X-Rate-Limit", "36/200")
+			return res, nil
+		},
+	)
+
+	c := NewClient("")
+	used, limit := c.RateLimit()
+	assert.Equal(t, 0, used)
+	assert.Equal(t, 0, limit)
+
+	err := c.(*client).GET("/foo", &struct{}{})
+	assert.NoError(t, err)
+
+	used, limit = c.RateLimit()
+	assert.Equal(t, 36, used)
+	assert.Equal(t, 200, limit)
+}
+
+func TestClient_RateLimitError(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(http.MethodGet, fmt.Sprintf("%s%s", apiEndpoint, "/foo"),
+		func(req *http.Request) (*http.Response, error) {
+			res := httpmock.NewStringResponse(http.StatusTooManyRequests, `{
+  "error": {
+    "id": "429",
+    "name": "too_many_requests",
+    "detail": "Too many requests"
+  }
+}`)
+			res.Header.Add("X-Rate-Limit", "200/200")
+			res.Header.Add("Retry-After", "120")
+			return res, nil
+		},
+	)
+
+	c := NewClient("")
+	err := c.(*client).GET("/foo", &struct{}{})
+
+	var rateLimitErr *api.RateLimitError
+	assert.True(t, errors.As(err, &rateLimitErr))
+	assert.Equal(t, api.RateLimit{Used: 200, Limit: 200}, rateLimitErr.RateLimit)
+	assert.Equal(t, 2*time.Minute, rateLimitErr.RetryAfter)
+	assert.Equal(t, "429", rateLimitErr.Err.ID)
+	assert.Equal(t, "too_many_requests", rateLimitErr.Err.Name)
+	assert.Equal(t, "Too many requests", rateLimitErr.Err.Detail)
+	assert.ErrorIs(t, err, api.ErrRateLimited)
+}
+
+func TestWithRateLimitPolicy(t *testing.T) {
+	t.Run("ignore", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		calls := 0
+		httpmock.RegisterResponder(http.MethodGet, fmt.Sprintf("%s%s", apiEndpoint, "/foo"),
+			func(req *http.Request) (*http.Response, error) {
+				calls++
+				res := httpmock.NewStringResponse(http.StatusOK, `{}`)
+				res.Header.Add("X-Rate-Limit", "200/200")
+				return res, nil
+			},
+		)
+
+		c := NewClient("")
+		assert.NoError(t, c.(*client).GET("/foo", &struct{}{}))
+		assert.NoError(t, c.(*client).GET("/foo", &struct{}{}))
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("fail fast", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		calls := 0
+		httpmock.RegisterResponder(http.MethodGet, fmt.Sprintf("%s%s", apiEndpoint, "/foo"),
+			func(req *http.Request) (*http.Response, error) {
+				calls++
+				res := httpmock.NewStringResponse(http.StatusOK, `{}`)
+				res.Header.Add("X-Rate-Limit", "200/200")
+				return res, nil
+			},
+		)
+
+		c := NewClient("", WithRateLimitPolicy(RateLimitFailFast))
+		assert.NoError(t, c.(*client).GET("/foo", &struct{}{}))
+
+		err := c.(*client).GET("/foo", &struct{}{})
+		var rateLimitErr *api.RateLimitError
+		assert.True(t, errors.As(err, &rateLimitErr))
+		assert.Equal(t, api.RateLimit{Used: 200, Limit: 200}, rateLimitErr.RateLimit)
+		assert.True(t, rateLimitErr.RetryAfter > 0)
+		assert.Nil(t, rateLimitErr.Err)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("wait", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		httpmock.RegisterResponder(http.MethodGet, fmt.Sprintf("%s%s", apiEndpoint, "/foo"),
+			func(req *http.Request) (*http.Response, error) {
+				res := httpmock.NewStringResponse(http.StatusOK, `{}`)
+				res.Header.Add("X-Rate-Limit", "1/200")
+				return res, nil
+			},
+		)
+
+		c := NewClient("", WithRateLimitPolicy(RateLimitWait))
+		c.(*client).rateLimitResetAt = time.Now().Add(20 * time.Millisecond)
+
+		started := time.Now()
+		assert.NoError(t, c.(*client).GET("/foo", &struct{}{}))
+		assert.True(t, time.Since(started) >= 20*time.Millisecond)
+	})
+
+	t.Run("wait with cancelled context", func(t *testing.T) {
+		c := NewClient("", WithRateLimitPolicy(RateLimitWait))
+		c.(*client).rateLimitResetAt = time.Now().Add(time.Hour)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		err := c.(*client).GETWithContext(ctx, "/foo", &struct{}{})
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	assert.Equal(t, time.Duration(0), parseRetryAfter(""))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("foo"))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("-1"))
+	assert.Equal(t, 30*time.Second, parseRetryAfter("30"))
+
+	d := parseRetryAfter(time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+	assert.True(t, d > 50*time.Second && d <= time.Minute)
+	assert.Equal(t, time.Duration(0),
+		parseRetryAfter(time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat)))
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package ynab
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"github.com/brunomvsouza/ynab.go/api"
+)
+
+// RetryPolicy configures the automatic retry of requests failed due to
+// transient errors: network failures, HTTP 429 and HTTP 5xx responses.
+//
+// Only requests safe to be repeated are retried: GET requests and write
+// requests marked as idempotent with api.WithIdempotent, e.g. transactions
+// created with an ImportID.
+type RetryPolicy struct {
+	// MaxAttempts the maximum number of times a request is sent,
+	// including the first attempt
+	MaxAttempts int
+	// BaseDelay the delay before the first retry, doubled on every
+	// subsequent retry
+	BaseDelay time.Duration
+	// MaxDelay the upper bound of the delay between two attempts
+	MaxDelay time.Duration
+}
+
+// DefaultRetryPolicy is a sensible retry policy for most use cases, used
+// by clients created without WithRetryPolicy
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	BaseDelay:   500 * time.Millisecond,
+	MaxDelay:    30 * time.Second,
+}
+
+// WithRetryPolicy sets the policy for the automatic retry of requests
+// failed due to transient errors. Defaults to DefaultRetryPolicy. Retries
+// are disabled by a policy with MaxAttempts set to 1.
+func WithRetryPolicy(p RetryPolicy) Option {
+	return func(c *client) {
+		c.retryPolicy = p
+	}
+}
+
+// canRetry tells whether a request can be retried under the policy
+func (p RetryPolicy) canRetry(ctx context.Context, method string) bool {
+	return p.MaxAttempts > 1 && (method == http.MethodGet || api.IsIdempotent(ctx))
+}
+
+// shouldRetry tells whether the outcome of an attempt is a transient failure
+func (p RetryPolicy) shouldRetry(ctx context.Context, res *http.Response, err error) bool {
+	if err != nil {
+		return ctx.Err() == nil
+	}
+
+	switch res.StatusCode {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// delay returns how long to wait before the given retry, honoring the
+// Retry-After header of the previous response when available, and whether
+// to retry at all. Requests are not retried when the API asks to wait
+// longer than MaxDelay, e.g. until the rate limit window is over.
+func (p RetryPolicy) delay(retry int, res *http.Response) (time.Duration, bool) {
+	if res != nil {
+		wait := parseRetryAfter(res.Header.Get("Retry-After"))
+		if wait <= 0 && res.StatusCode == http.StatusTooManyRequests {
+			if rl, err := api.ParseRateLimit(res.Header.Get("X-Rate-Limit")); err == nil && rl.Exceeded() {
+				wait = api.RateLimitWindow
+			}
+		}
+		if wait > 0 {
+			return wait, p.MaxDelay <= 0 || wait <= p.MaxDelay
+		}
+	}
+
+	d := p.BaseDelay
+	for i := 1; i < retry && d < p.MaxDelay; i++ {
+		d *= 2
+	}
+	if p.MaxDelay > 0 && d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+	if d <= 0 {
+		return 0, true
+	}
+
+	// equal jitter: half of the delay is fixed and the other half random
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(d-half)+1)), true
+}
+
+// sleep waits for d or until ctx is done
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
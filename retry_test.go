@@ -0,0 +1,259 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package ynab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+
+	"github.com/brunomvsouza/ynab.go/api"
+)
+
+var testRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	BaseDelay:   time.Millisecond,
+	MaxDelay:    5 * time.Millisecond,
+}
+
+func TestWithRetryPolicy(t *testing.T) {
+	t.Run("retries GET until success", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		calls := 0
+		httpmock.RegisterResponder(http.MethodGet, fmt.Sprintf("%s%s", apiEndpoint, "/foo"),
+			func(req *http.Request) (*http.Response, error) {
+				calls++
+				if calls < 3 {
+					return httpmock.NewStringResponse(http.StatusServiceUnavailable, ""), nil
+				}
+				return httpmock.NewStringResponse(http.StatusOK, `{"foo":"bar"}`), nil
+			},
+		)
+
+		response := struct {
+			Foo string `json:"foo"`
+		}{}
+
+		c := NewClient("", WithRetryPolicy(testRetryPolicy))
+		err := c.(*client).GET("/foo", &response)
+		assert.NoError(t, err)
+		assert.Equal(t, "bar", response.Foo)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("retries network errors", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		calls := 0
+		httpmock.RegisterResponder(http.MethodGet, fmt.Sprintf("%s%s", apiEndpoint, "/foo"),
+			func(req *http.Request) (*http.Response, error) {
+				calls++
+				if calls < 2 {
+					return nil, errors.New("connection reset by peer")
+				}
+				return httpmock.NewStringResponse(http.StatusOK, `{}`), nil
+			},
+		)
+
+		c := NewClient("", WithRetryPolicy(testRetryPolicy))
+		err := c.(*client).GET("/foo", &struct{}{})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("gives up after max attempts", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		calls := 0
+		httpmock.RegisterResponder(http.MethodGet, fmt.Sprintf("%s%s", apiEndpoint, "/foo"),
+			func(req *http.Request) (*http.Response, error) {
+				calls++
+				return httpmock.NewStringResponse(http.StatusInternalServerError, ""), nil
+			},
+		)
+
+		c := NewClient("", WithRetryPolicy(testRetryPolicy))
+		err := c.(*client).GET("/foo", &struct{}{})
+		assert.EqualError(t, err, "api: error id=500 name=unknown_api_error detail=Unknown API error")
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("does not retry client errors", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		calls := 0
+		httpmock.RegisterResponder(http.MethodGet, fmt.Sprintf("%s%s", apiEndpoint, "/foo"),
+			func(req *http.Request) (*http.Response, error) {
+				calls++
+				return httpmock.NewStringResponse(http.StatusNotFound, ""), nil
+			},
+		)
+
+		c := NewClient("", WithRetryPolicy(testRetryPolicy))
+		err := c.(*client).GET("/foo", &struct{}{})
+		assert.Error(t, err)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("does not retry non idempotent writes", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		calls := 0
+		httpmock.RegisterResponder(http.MethodPost, fmt.Sprintf("%s%s", apiEndpoint, "/foo"),
+			func(req *http.Request) (*http.Response, error) {
+				calls++
+				return httpmock.NewStringResponse(http.StatusServiceUnavailable, ""), nil
+			},
+		)
+
+		c := NewClient("", WithRetryPolicy(testRetryPolicy))
+		err := c.(*client).POST("/foo", &struct{}{}, []byte(`{}`))
+		assert.Error(t, err)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("retries idempotent writes", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		calls := 0
+		httpmock.RegisterResponder(http.MethodPost, fmt.Sprintf("%s%s", apiEndpoint, "/foo"),
+			func(req *http.Request) (*http.Response, error) {
+				calls++
+				if calls < 2 {
+					return httpmock.NewStringResponse(http.StatusServiceUnavailable, ""), nil
+				}
+				return httpmock.NewStringResponse(http.StatusOK, `{}`), nil
+			},
+		)
+
+		c := NewClient("", WithRetryPolicy(testRetryPolicy))
+		ctx := api.WithIdempotent(context.Background())
+		err := c.(*client).POSTWithContext(ctx, "/foo", &struct{}{}, []byte(`{}`))
+		assert.NoError(t, err)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("enabled by default", func(t *testing.T) {
+		c := NewClient("")
+		assert.Equal(t, DefaultRetryPolicy, c.(*client).retryPolicy)
+	})
+
+	t.Run("disabled with a single attempt", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		calls := 0
+		httpmock.RegisterResponder(http.MethodGet, fmt.Sprintf("%s%s", apiEndpoint, "/foo"),
+			func(req *http.Request) (*http.Response, error) {
+				calls++
+				return httpmock.NewStringResponse(http.StatusServiceUnavailable, ""), nil
+			},
+		)
+
+		c := NewClient("", WithRetryPolicy(RetryPolicy{MaxAttempts: 1}))
+		err := c.(*client).GET("/foo", &struct{}{})
+		assert.Error(t, err)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("does not wait longer than max delay", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		calls := 0
+		httpmock.RegisterResponder(http.MethodGet, fmt.Sprintf("%s%s", apiEndpoint, "/foo"),
+			func(req *http.Request) (*http.Response, error) {
+				calls++
+				res := httpmock.NewStringResponse(http.StatusTooManyRequests, "")
+				res.Header.Add("Retry-After", "3600")
+				return res, nil
+			},
+		)
+
+		c := NewClient("", WithRetryPolicy(testRetryPolicy))
+		start := time.Now()
+		err := c.(*client).GET("/foo", &struct{}{})
+		assert.True(t, time.Since(start) < time.Second)
+		assert.Equal(t, 1, calls)
+
+		var rateLimitErr *api.RateLimitError
+		if assert.True(t, errors.As(err, &rateLimitErr)) {
+			assert.Equal(t, time.Hour, rateLimitErr.RetryAfter)
+		}
+	})
+
+	t.Run("stops when the context is done", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		httpmock.RegisterResponder(http.MethodGet, fmt.Sprintf("%s%s", apiEndpoint, "/foo"),
+			func(req *http.Request) (*http.Response, error) {
+				return httpmock.NewStringResponse(http.StatusServiceUnavailable, ""), nil
+			},
+		)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		c := NewClient("", WithRetryPolicy(RetryPolicy{
+			MaxAttempts: 3,
+			BaseDelay:   time.Hour,
+			MaxDelay:    time.Hour,
+		}))
+		err := c.(*client).GETWithContext(ctx, "/foo", &struct{}{})
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+func TestRetryPolicy_delay(t *testing.T) {
+	p := RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: 300 * time.Millisecond}
+
+	table := []struct {
+		Retry int
+		Min   time.Duration
+		Max   time.Duration
+	}{
+		{1, 50 * time.Millisecond, 100 * time.Millisecond},
+		{2, 100 * time.Millisecond, 200 * time.Millisecond},
+		{3, 150 * time.Millisecond, 300 * time.Millisecond},
+		{10, 150 * time.Millisecond, 300 * time.Millisecond},
+	}
+
+	for _, test := range table {
+		d, ok := p.delay(test.Retry, nil)
+		assert.True(t, ok)
+		assert.True(t, d >= test.Min && d <= test.Max, "retry %d: %s", test.Retry, d)
+	}
+
+	p.MaxDelay = 10 * time.Second
+	res := &http.Response{Header: http.Header{"Retry-After": []string{"7"}}}
+	d, ok := p.delay(1, res)
+	assert.True(t, ok)
+	assert.Equal(t, 7*time.Second, d)
+
+	res = &http.Response{Header: http.Header{"Retry-After": []string{"3600"}}}
+	_, ok = p.delay(1, res)
+	assert.False(t, ok)
+
+	res = &http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{"X-Rate-Limit": []string{"200/200"}},
+	}
+	_, ok = p.delay(1, res)
+	assert.False(t, ok)
+}
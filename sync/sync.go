@@ -0,0 +1,275 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+// Package sync keeps an in-memory copy of a budget up to date by merging
+// the deltas returned by the API since the last known server knowledge
+// https://api.youneedabudget.com/#deltas
+package sync // import "github.com/brunomvsouza/ynab.go/sync"
+
+import (
+	"context"
+	"errors"
+	stdsync "sync"
+
+	"github.com/brunomvsouza/ynab.go/api"
+	"github.com/brunomvsouza/ynab.go/api/account"
+	"github.com/brunomvsouza/ynab.go/api/budget"
+	"github.com/brunomvsouza/ynab.go/api/category"
+	"github.com/brunomvsouza/ynab.go/api/month"
+	"github.com/brunomvsouza/ynab.go/api/payee"
+	"github.com/brunomvsouza/ynab.go/api/transaction"
+)
+
+// ErrInvalidSnapshot is returned when restoring from a snapshot without
+// a budget
+var ErrInvalidSnapshot = errors.New("sync: snapshot without budget")
+
+// Fetcher fetches a budget, or only the changes made to it since
+// the server knowledge of the filter. It is implemented by
+// budget.Service.
+type Fetcher interface {
+	GetBudgetWithContext(ctx context.Context, budgetID string,
+		f *api.Filter) (*budget.Snapshot, error)
+}
+
+// Engine keeps the merged state of a budget. The first sync fetches the
+// whole budget and the following ones only the changes made since the
+// previous sync, removing the deleted entities.
+type Engine struct {
+	f        Fetcher
+	budgetID string
+
+	// syncing serializes syncs so deltas are merged in order
+	syncing stdsync.Mutex
+
+	mu              stdsync.RWMutex
+	budget          *budget.Budget
+	serverKnowledge uint64
+}
+
+// New creates a new sync engine for a budget
+func New(f Fetcher, budgetID string) *Engine {
+	return &Engine{
+		f:        f,
+		budgetID: budgetID,
+	}
+}
+
+// Restore creates a new sync engine from a previously taken snapshot,
+// e.g. one persisted between runs, so the next sync only fetches the
+// changes made since then. It returns ErrInvalidSnapshot when the snapshot
+// has no budget to restore.
+func Restore(f Fetcher, s *budget.Snapshot) (*Engine, error) {
+	if s == nil || s.Budget == nil || s.Budget.ID == "" {
+		return nil, ErrInvalidSnapshot
+	}
+
+	return &Engine{
+		f:               f,
+		budgetID:        s.Budget.ID,
+		budget:          copyBudget(s.Budget),
+		serverKnowledge: s.ServerKnowledge,
+	}, nil
+}
+
+// Sync fetches the changes made to the budget since the last sync and
+// merges them into the local state, returning the delta as received
+func (e *Engine) Sync() (*budget.Snapshot, error) {
+	return e.SyncWithContext(context.Background())
+}
+
+// SyncWithContext fetches the changes made to the budget since the last
+// sync and merges them into the local state, returning the delta as
+// received bound to ctx
+func (e *Engine) SyncWithContext(ctx context.Context) (*budget.Snapshot, error) {
+	e.syncing.Lock()
+	defer e.syncing.Unlock()
+
+	e.mu.RLock()
+	current, serverKnowledge := e.budget, e.serverKnowledge
+	e.mu.RUnlock()
+
+	var f *api.Filter
+	if current != nil {
+		f = &api.Filter{LastKnowledgeOfServer: serverKnowledge}
+	}
+
+	delta, err := e.f.GetBudgetWithContext(ctx, e.budgetID, f)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := mergeBudget(current, delta.Budget)
+
+	e.mu.Lock()
+	e.budget = merged
+	e.serverKnowledge = delta.ServerKnowledge
+	e.mu.Unlock()
+
+	return delta, nil
+}
+
+// Snapshot returns the merged state of the budget along with the server
+// knowledge it reflects, nil before the first sync. The entities of the
+// budget are shared with the engine and must not be modified.
+func (e *Engine) Snapshot() *budget.Snapshot {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	if e.budget == nil {
+		return nil
+	}
+	return &budget.Snapshot{
+		Budget:          copyBudget(e.budget),
+		ServerKnowledge: e.serverKnowledge,
+	}
+}
+
+// ServerKnowledge returns the server knowledge of the last sync
+func (e *Engine) ServerKnowledge() uint64 {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.serverKnowledge
+}
+
+// mergeBudget returns a new budget with the delta applied to current.
+// Entities are replaced rather than modified, so budgets previously
+// returned by Snapshot are left untouched.
+func mergeBudget(current, delta *budget.Budget) *budget.Budget {
+	if current == nil {
+		current = &budget.Budget{}
+	}
+	b := copyBudget(current)
+	if delta == nil {
+		return b
+	}
+
+	b.ID = delta.ID
+	if delta.Name != "" {
+		b.Name = delta.Name
+	}
+	if delta.DateFormat != nil {
+		b.DateFormat = delta.DateFormat
+	}
+	if delta.CurrencyFormat != nil {
+		b.CurrencyFormat = delta.CurrencyFormat
+	}
+	if delta.LastModifiedOn != nil {
+		b.LastModifiedOn = delta.LastModifiedOn
+	}
+	if delta.FirstMonth != nil {
+		b.FirstMonth = delta.FirstMonth
+	}
+	if delta.LastMonth != nil {
+		b.LastMonth = delta.LastMonth
+	}
+
+	b.Accounts = merge(b.Accounts, delta.Accounts, func(a *account.Account) (string, bool) {
+		return a.ID, a.Deleted
+	}, nil)
+	b.Payees = merge(b.Payees, delta.Payees, func(p *payee.Payee) (string, bool) {
+		return p.ID, p.Deleted
+	}, nil)
+	b.PayeeLocations = merge(b.PayeeLocations, delta.PayeeLocations, func(l *payee.Location) (string, bool) {
+		return l.ID, l.Deleted
+	}, nil)
+	b.CategoryGroups = merge(b.CategoryGroups, delta.CategoryGroups, func(g *category.Group) (string, bool) {
+		return g.ID, g.Deleted
+	}, nil)
+	b.Categories = merge(b.Categories, delta.Categories, categoryKey, nil)
+	b.Months = merge(b.Months, delta.Months, func(m *month.Month) (string, bool) {
+		return api.DateFormat(m.Month), m.Deleted
+	}, mergeMonth)
+	b.Transactions = merge(b.Transactions, delta.Transactions, func(t *transaction.Summary) (string, bool) {
+		return t.ID, t.Deleted
+	}, nil)
+	b.SubTransactions = merge(b.SubTransactions, delta.SubTransactions, func(t *transaction.SubTransaction) (string, bool) {
+		return t.ID, t.Deleted
+	}, nil)
+	b.ScheduledTransactions = merge(b.ScheduledTransactions, delta.ScheduledTransactions, func(t *transaction.ScheduledSummary) (string, bool) {
+		return t.ID, t.Deleted
+	}, nil)
+	b.ScheduledSubTransactions = merge(b.ScheduledSubTransactions, delta.ScheduledSubTransactions, func(t *transaction.ScheduledSubTransaction) (string, bool) {
+		return t.ID, t.Deleted
+	}, nil)
+
+	return b
+}
+
+// mergeMonth merges a changed month into its current version. Deltas of
+// a month only carry its changed categories.
+func mergeMonth(current, delta *month.Month) *month.Month {
+	m := *delta
+	m.Categories = merge(current.Categories, delta.Categories, categoryKey, nil)
+	return &m
+}
+
+// categoryKey returns the ID of a category and whether it was deleted
+func categoryKey(c *category.Category) (string, bool) {
+	return c.ID, c.Deleted
+}
+
+// merge returns a new list with the delta applied to items: changed items
+// replace the current ones with the same key, in place, new items are
+// appended in the order received and deleted items are removed. The
+// changed items are combined with the current ones by update when given.
+func merge[T any](items, delta []*T, key func(*T) (string, bool),
+	update func(current, delta *T) *T) []*T {
+
+	if len(delta) == 0 {
+		return items
+	}
+
+	positions := make(map[string]int, len(items))
+	merged := make([]*T, len(items), len(items)+len(delta))
+	for i, item := range items {
+		id, _ := key(item)
+		positions[id] = i
+		merged[i] = item
+	}
+
+	for _, item := range delta {
+		id, deleted := key(item)
+		i, ok := positions[id]
+		switch {
+		case deleted && ok:
+			merged[i] = nil
+		case deleted:
+		case ok && merged[i] != nil && update != nil:
+			merged[i] = update(merged[i], item)
+		case ok:
+			merged[i] = item
+		default:
+			positions[id] = len(merged)
+			merged = append(merged, item)
+		}
+	}
+
+	// drop the deleted items keeping the order of the others
+	n := 0
+	for _, item := range merged {
+		if item != nil {
+			merged[n] = item
+			n++
+		}
+	}
+	return merged[:n]
+}
+
+// copyBudget returns a copy of b whose lists can be modified without
+// affecting b
+func copyBudget(b *budget.Budget) *budget.Budget {
+	c := *b
+	c.Accounts = append([]*account.Account(nil), b.Accounts...)
+	c.Payees = append([]*payee.Payee(nil), b.Payees...)
+	c.PayeeLocations = append([]*payee.Location(nil), b.PayeeLocations...)
+	c.Categories = append([]*category.Category(nil), b.Categories...)
+	c.CategoryGroups = append([]*category.Group(nil), b.CategoryGroups...)
+	c.Months = append([]*month.Month(nil), b.Months...)
+	c.Transactions = append([]*transaction.Summary(nil), b.Transactions...)
+	c.SubTransactions = append([]*transaction.SubTransaction(nil), b.SubTransactions...)
+	c.ScheduledTransactions = append([]*transaction.ScheduledSummary(nil), b.ScheduledTransactions...)
+	c.ScheduledSubTransactions = append([]*transaction.ScheduledSubTransaction(nil), b.ScheduledSubTransactions...)
+	return &c
+}
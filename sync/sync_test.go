@@ -0,0 +1,287 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package sync_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+
+	"github.com/brunomvsouza/ynab.go"
+	"github.com/brunomvsouza/ynab.go/api"
+	"github.com/brunomvsouza/ynab.go/api/budget"
+	"github.com/brunomvsouza/ynab.go/sync"
+)
+
+const budgetURL = "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c"
+
+const fullBudget = `{
+  "data": {
+    "budget": {
+      "id": "aa248caa-eed7-4575-a990-717386438d2c",
+      "name": "TEST BUDGET",
+      "accounts": [
+        {"id": "account-1", "name": "Checking", "balance": 1000, "deleted": false},
+        {"id": "account-2", "name": "Savings", "balance": 2000, "deleted": false}
+      ],
+      "payees": [
+        {"id": "payee-1", "name": "Supermarket", "deleted": false},
+        {"id": "payee-2", "name": "Bakery", "deleted": false}
+      ],
+      "payee_locations": [
+        {"id": "location-1", "payee_id": "payee-2", "deleted": false}
+      ],
+      "category_groups": [
+        {"id": "group-1", "name": "Bills", "hidden": false, "deleted": false}
+      ],
+      "categories": [
+        {"id": "category-1", "category_group_id": "group-1", "name": "Rent", "budgeted": 100, "deleted": false},
+        {"id": "category-2", "category_group_id": "group-1", "name": "Power", "budgeted": 200, "deleted": false}
+      ],
+      "months": [
+        {
+          "month": "2018-03-01",
+          "income": 0,
+          "deleted": false,
+          "categories": [
+            {"id": "category-1", "category_group_id": "group-1", "name": "Rent", "budgeted": 100, "deleted": false},
+            {"id": "category-2", "category_group_id": "group-1", "name": "Power", "budgeted": 200, "deleted": false}
+          ]
+        }
+      ],
+      "transactions": [
+        {"id": "transaction-1", "date": "2018-03-10", "amount": -1000, "deleted": false},
+        {"id": "transaction-2", "date": "2018-03-11", "amount": -2000, "deleted": false}
+      ],
+      "subtransactions": [
+        {"id": "sub-1", "transaction_id": "transaction-2", "amount": -1000, "deleted": false},
+        {"id": "sub-2", "transaction_id": "transaction-2", "amount": -1000, "deleted": false}
+      ],
+      "scheduled_transactions": [
+        {"id": "scheduled-1", "date_first": "2018-03-10", "date_next": "2018-04-10", "frequency": "monthly", "amount": -1000, "deleted": false}
+      ],
+      "scheduled_subtransactions": [
+        {"id": "scheduled-sub-1", "scheduled_transaction_id": "scheduled-1", "amount": -1000, "deleted": false}
+      ]
+    },
+    "server_knowledge": 10
+  }
+}`
+
+const deltaBudget = `{
+  "data": {
+    "budget": {
+      "id": "aa248caa-eed7-4575-a990-717386438d2c",
+      "name": "TEST BUDGET",
+      "accounts": [
+        {"id": "account-1", "name": "Checking", "balance": 500, "deleted": false}
+      ],
+      "payees": [
+        {"id": "payee-2", "name": "Bakery", "deleted": true}
+      ],
+      "payee_locations": [
+        {"id": "location-1", "payee_id": "payee-2", "deleted": true}
+      ],
+      "category_groups": [
+        {"id": "group-2", "name": "Fun", "hidden": false, "deleted": false}
+      ],
+      "categories": [
+        {"id": "category-2", "category_group_id": "group-1", "name": "Power", "budgeted": 250, "deleted": false}
+      ],
+      "months": [
+        {
+          "month": "2018-03-01",
+          "income": 5000,
+          "deleted": false,
+          "categories": [
+            {"id": "category-2", "category_group_id": "group-1", "name": "Power", "budgeted": 250, "deleted": false}
+          ]
+        },
+        {
+          "month": "2018-04-01",
+          "income": 0,
+          "deleted": false,
+          "categories": []
+        }
+      ],
+      "transactions": [
+        {"id": "transaction-1", "date": "2018-03-10", "amount": -1000, "deleted": true},
+        {"id": "transaction-2", "date": "2018-03-11", "amount": -2000, "memo": "split", "deleted": false},
+        {"id": "transaction-3", "date": "2018-03-12", "amount": -3000, "deleted": false}
+      ],
+      "subtransactions": [
+        {"id": "sub-2", "transaction_id": "transaction-2", "amount": -1000, "deleted": true},
+        {"id": "sub-3", "transaction_id": "transaction-2", "amount": -1000, "deleted": false}
+      ],
+      "scheduled_transactions": [
+        {"id": "scheduled-1", "date_first": "2018-03-10", "date_next": "2018-04-10", "frequency": "monthly", "amount": -1000, "deleted": true}
+      ],
+      "scheduled_subtransactions": [
+        {"id": "scheduled-sub-1", "scheduled_transaction_id": "scheduled-1", "amount": -1000, "deleted": true}
+      ]
+    },
+    "server_knowledge": 12
+  }
+}`
+
+func TestEngine_Sync(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(http.MethodGet, budgetURL,
+		httpmock.NewStringResponder(200, fullBudget))
+	httpmock.RegisterResponder(http.MethodGet, budgetURL+"?last_knowledge_of_server=10",
+		httpmock.NewStringResponder(200, deltaBudget))
+
+	client := ynab.NewClient("")
+	e := sync.New(client.Budget(), "aa248caa-eed7-4575-a990-717386438d2c")
+	assert.Nil(t, e.Snapshot())
+
+	_, err := e.Sync()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(10), e.ServerKnowledge())
+
+	before := e.Snapshot()
+	assert.Equal(t, uint64(10), before.ServerKnowledge)
+	assert.Len(t, before.Budget.Accounts, 2)
+
+	delta, err := e.Sync()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(12), delta.ServerKnowledge)
+	assert.Len(t, delta.Budget.Transactions, 3)
+	assert.Equal(t, uint64(12), e.ServerKnowledge())
+
+	s := e.Snapshot()
+	assert.Equal(t, uint64(12), s.ServerKnowledge)
+	b := s.Budget
+	assert.Equal(t, "aa248caa-eed7-4575-a990-717386438d2c", b.ID)
+	assert.Equal(t, "TEST BUDGET", b.Name)
+
+	if assert.Len(t, b.Accounts, 2) {
+		assert.Equal(t, "account-1", b.Accounts[0].ID)
+		assert.Equal(t, int64(500), b.Accounts[0].Balance)
+		assert.Equal(t, "account-2", b.Accounts[1].ID)
+	}
+
+	if assert.Len(t, b.Payees, 1) {
+		assert.Equal(t, "payee-1", b.Payees[0].ID)
+	}
+	assert.Empty(t, b.PayeeLocations)
+
+	if assert.Len(t, b.CategoryGroups, 2) {
+		assert.Equal(t, "group-1", b.CategoryGroups[0].ID)
+		assert.Equal(t, "group-2", b.CategoryGroups[1].ID)
+	}
+
+	if assert.Len(t, b.Categories, 2) {
+		assert.Equal(t, int64(100), b.Categories[0].Budgeted)
+		assert.Equal(t, int64(250), b.Categories[1].Budgeted)
+	}
+
+	if assert.Len(t, b.Months, 2) {
+		assert.Equal(t, "2018-03-01", api.DateFormat(b.Months[0].Month))
+		assert.Equal(t, int64(5000), *b.Months[0].Income)
+		if assert.Len(t, b.Months[0].Categories, 2) {
+			assert.Equal(t, int64(100), b.Months[0].Categories[0].Budgeted)
+			assert.Equal(t, int64(250), b.Months[0].Categories[1].Budgeted)
+		}
+		assert.Equal(t, "2018-04-01", api.DateFormat(b.Months[1].Month))
+	}
+
+	if assert.Len(t, b.Transactions, 2) {
+		assert.Equal(t, "transaction-2", b.Transactions[0].ID)
+		assert.Equal(t, "split", *b.Transactions[0].Memo)
+		assert.Equal(t, "transaction-3", b.Transactions[1].ID)
+	}
+
+	if assert.Len(t, b.SubTransactions, 2) {
+		assert.Equal(t, "sub-1", b.SubTransactions[0].ID)
+		assert.Equal(t, "sub-3", b.SubTransactions[1].ID)
+	}
+
+	assert.Empty(t, b.ScheduledTransactions)
+	assert.Empty(t, b.ScheduledSubTransactions)
+
+	// snapshots taken before a sync are left untouched
+	assert.Len(t, before.Budget.Transactions, 2)
+	assert.Equal(t, "transaction-1", before.Budget.Transactions[0].ID)
+	assert.Equal(t, int64(0), *before.Budget.Months[0].Income)
+	assert.Equal(t, int64(200), before.Budget.Months[0].Categories[1].Budgeted)
+}
+
+func TestEngine_Sync_error(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(http.MethodGet, budgetURL,
+		httpmock.NewStringResponder(200, fullBudget))
+	httpmock.RegisterResponder(http.MethodGet, budgetURL+"?last_knowledge_of_server=10",
+		httpmock.NewStringResponder(500, `{
+  "error": {
+    "id": "500",
+    "name": "internal_server_error",
+    "detail": "Internal server error"
+  }
+}`))
+
+	client := ynab.NewClient("", ynab.WithRetryPolicy(ynab.RetryPolicy{MaxAttempts: 1}))
+	e := sync.New(client.Budget(), "aa248caa-eed7-4575-a990-717386438d2c")
+
+	_, err := e.Sync()
+	assert.NoError(t, err)
+
+	delta, err := e.Sync()
+	assert.Nil(t, delta)
+	assert.True(t, errors.Is(err, api.ErrInternalServer))
+
+	assert.Equal(t, uint64(10), e.ServerKnowledge())
+	assert.Len(t, e.Snapshot().Budget.Transactions, 2)
+}
+
+func TestRestore(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(http.MethodGet, budgetURL,
+		httpmock.NewStringResponder(200, fullBudget))
+	httpmock.RegisterResponder(http.MethodGet, budgetURL+"?last_knowledge_of_server=10",
+		httpmock.NewStringResponder(200, deltaBudget))
+
+	client := ynab.NewClient("")
+	full, err := client.Budget().GetBudget("aa248caa-eed7-4575-a990-717386438d2c", nil)
+	assert.NoError(t, err)
+
+	e, err := sync.Restore(client.Budget(), full)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(10), e.ServerKnowledge())
+	assert.Equal(t, full.Budget, e.Snapshot().Budget)
+
+	_, err = e.Sync()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(12), e.ServerKnowledge())
+	assert.Len(t, e.Snapshot().Budget.Transactions, 2)
+
+	// the restored snapshot is left untouched
+	assert.Len(t, full.Budget.Transactions, 2)
+	assert.Equal(t, "transaction-1", full.Budget.Transactions[0].ID)
+}
+
+func TestRestore_invalidSnapshot(t *testing.T) {
+	client := ynab.NewClient("")
+
+	table := []*budget.Snapshot{
+		nil,
+		{ServerKnowledge: 10},
+		{Budget: &budget.Budget{}, ServerKnowledge: 10},
+	}
+	for _, s := range table {
+		e, err := sync.Restore(client.Budget(), s)
+		assert.Nil(t, e)
+		assert.Equal(t, sync.ErrInvalidSnapshot, err)
+	}
+}